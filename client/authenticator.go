@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const DEFAULT_TOKEN_EXPIRY_MARGIN = 1 * time.Minute
+
+// Authenticator adds the SoftLayer credentials to each outgoing HTTP request, credentials are never part of the request URL
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// RefreshableAuthenticator is an Authenticator whose credentials can be discarded and fetched again, e.g. after a 401 response
+type RefreshableAuthenticator interface {
+	Authenticator
+
+	Invalidate()
+}
+
+// CredentialsAuthenticator is an Authenticator based on a username and API key, which the XML-RPC
+// transport also sends in its authenticate header
+type CredentialsAuthenticator interface {
+	Authenticator
+
+	Credentials() (Credentials, error)
+}
+
+type BasicAuthenticator struct {
+	Username string
+	ApiKey   string
+}
+
+func NewBasicAuthenticator(username, apiKey string) *BasicAuthenticator {
+	return &BasicAuthenticator{
+		Username: username,
+		ApiKey:   apiKey,
+	}
+}
+
+func (a *BasicAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.ApiKey)
+	return nil
+}
+
+func (a *BasicAuthenticator) Credentials() (Credentials, error) {
+	return Credentials{Username: a.Username, ApiKey: a.ApiKey}, nil
+}
+
+type Token struct {
+	AccessToken string
+
+	//Zero for tokens which never expire
+	ExpiresAt time.Time
+}
+
+func (t Token) IsExpired(margin time.Duration) bool {
+	if t.ExpiresAt.IsZero() {
+		return false
+	}
+
+	return time.Now().Add(margin).After(t.ExpiresAt)
+}
+
+// TokenSource fetches a new bearer token, e.g. by exchanging an IBM Cloud IAM API key
+type TokenSource func(ctx context.Context) (Token, error)
+
+type BearerTokenAuthenticator struct {
+	//Tokens expiring within ExpiryMargin are refreshed before being used
+	ExpiryMargin time.Duration
+
+	tokenSource TokenSource
+
+	token Token
+	mutex sync.Mutex
+}
+
+func NewBearerTokenAuthenticator(tokenSource TokenSource) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{
+		ExpiryMargin: DEFAULT_TOKEN_EXPIRY_MARGIN,
+
+		tokenSource: tokenSource,
+	}
+}
+
+func NewStaticTokenAuthenticator(accessToken string) *BearerTokenAuthenticator {
+	return NewBearerTokenAuthenticator(func(ctx context.Context) (Token, error) {
+		return Token{AccessToken: accessToken}, nil
+	})
+}
+
+func (a *BearerTokenAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	token, err := a.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return nil
+}
+
+// Token returns the cached token, refreshing it from the TokenSource when it is missing or about to expire
+func (a *BearerTokenAuthenticator) Token(ctx context.Context) (Token, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.token.AccessToken != "" && !a.token.IsExpired(a.ExpiryMargin) {
+		return a.token, nil
+	}
+
+	if a.tokenSource == nil {
+		return Token{}, errors.New("softlayer-go: bearer token authenticator has no token source")
+	}
+
+	token, err := a.tokenSource(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if token.AccessToken == "" {
+		return Token{}, errors.New("softlayer-go: token source returned an empty access token")
+	}
+
+	a.token = token
+	return a.token, nil
+}
+
+func (a *BearerTokenAuthenticator) Invalidate() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.token = Token{}
+}
+
+// CredentialChainAuthenticator uses basic authentication with the first credentials found by its providers, resolved on the first request
+type CredentialChainAuthenticator struct {
+	providers []CredentialProvider
+
+	credentials *Credentials
+	mutex       sync.Mutex
+}
+
+func NewCredentialChainAuthenticator(providers ...CredentialProvider) *CredentialChainAuthenticator {
+	if len(providers) == 0 {
+		providers = DefaultCredentialProviders()
+	}
+
+	return &CredentialChainAuthenticator{
+		providers: providers,
+	}
+}
+
+func (a *CredentialChainAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	credentials, err := a.Credentials()
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(credentials.Username, credentials.ApiKey)
+	return nil
+}
+
+func (a *CredentialChainAuthenticator) Credentials() (Credentials, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.credentials != nil {
+		return *a.credentials, nil
+	}
+
+	credentials, err := NewChainCredentialProvider(a.providers...).Retrieve()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	a.credentials = &credentials
+	return credentials, nil
+}
+
+func (a *CredentialChainAuthenticator) Invalidate() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.credentials = nil
+}
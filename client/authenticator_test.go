@@ -0,0 +1,183 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("Authenticator", func() {
+	var (
+		server *httptest.Server
+
+		authorizations []string
+		userInfos      []string
+		statusCodes    []int
+	)
+
+	BeforeEach(func() {
+		authorizations = []string{}
+		userInfos = []string{}
+		statusCodes = []int{}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorizations = append(authorizations, r.Header.Get("Authorization"))
+			userInfos = append(userInfos, r.URL.User.String())
+
+			statusCode := http.StatusOK
+			if len(authorizations) <= len(statusCodes) {
+				statusCode = statusCodes[len(authorizations)-1]
+			}
+
+			w.WriteHeader(statusCode)
+			w.Write([]byte(`{}`))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newHttpClient := func(authenticator slclient.Authenticator) *slclient.HttpClient {
+		httpClient := slclient.NewHttpClientWithAuthenticator(authenticator, strings.TrimPrefix(server.URL, "http://"), "templates", false)
+		httpClient.RetryPolicy = slclient.NoRetryPolicy()
+
+		return httpClient
+	}
+
+	Context("BasicAuthenticator", func() {
+		It("sends the credentials in a basic Authorization header instead of the URL", func() {
+			httpClient := slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), "templates", false)
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			req.SetBasicAuth("fake-username", "fake-api-key")
+			Expect(authorizations).To(Equal([]string{req.Header.Get("Authorization")}))
+			Expect(userInfos).To(Equal([]string{""}))
+		})
+	})
+
+	Context("BearerTokenAuthenticator", func() {
+		var (
+			tokens      []slclient.Token
+			tokenErr    error
+			fetchCount  int
+			tokenSource slclient.TokenSource
+		)
+
+		BeforeEach(func() {
+			tokens = []slclient.Token{}
+			tokenErr = nil
+			fetchCount = 0
+
+			tokenSource = func(ctx context.Context) (slclient.Token, error) {
+				fetchCount++
+				if tokenErr != nil {
+					return slclient.Token{}, tokenErr
+				}
+
+				return tokens[fetchCount-1], nil
+			}
+		})
+
+		It("sends the token as a bearer Authorization header and caches it", func() {
+			tokens = []slclient.Token{{AccessToken: "token-1", ExpiresAt: time.Now().Add(1 * time.Hour)}}
+			httpClient := newHttpClient(slclient.NewBearerTokenAuthenticator(tokenSource))
+
+			for i := 0; i < 2; i++ {
+				_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(authorizations).To(Equal([]string{"Bearer token-1", "Bearer token-1"}))
+			Expect(fetchCount).To(Equal(1))
+		})
+
+		It("refreshes tokens about to expire", func() {
+			tokens = []slclient.Token{
+				{AccessToken: "token-1", ExpiresAt: time.Now().Add(30 * time.Second)},
+				{AccessToken: "token-2", ExpiresAt: time.Now().Add(1 * time.Hour)},
+			}
+			httpClient := newHttpClient(slclient.NewBearerTokenAuthenticator(tokenSource))
+
+			for i := 0; i < 2; i++ {
+				_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(authorizations).To(Equal([]string{"Bearer token-1", "Bearer token-2"}))
+		})
+
+		It("refreshes the token and replays the request once on a 401", func() {
+			statusCodes = []int{http.StatusUnauthorized}
+			tokens = []slclient.Token{{AccessToken: "revoked"}, {AccessToken: "token-2"}}
+			httpClient := newHttpClient(slclient.NewBearerTokenAuthenticator(tokenSource))
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/createObject.json", "POST", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(authorizations).To(Equal([]string{"Bearer revoked", "Bearer token-2"}))
+		})
+
+		It("does not send the request when the token cannot be fetched", func() {
+			tokenErr = errors.New("fake-token-error")
+			httpClient := newHttpClient(slclient.NewBearerTokenAuthenticator(tokenSource))
+
+			_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).To(Equal(tokenErr))
+			Expect(authorizations).To(BeEmpty())
+		})
+
+		It("supports static tokens", func() {
+			httpClient := newHttpClient(slclient.NewStaticTokenAuthenticator("static-token"))
+
+			_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorizations).To(Equal([]string{"Bearer static-token"}))
+		})
+	})
+
+	Context("CredentialChainAuthenticator", func() {
+		It("uses the first provider with credentials", func() {
+			authenticator := slclient.NewCredentialChainAuthenticator(
+				fakeCredentialProvider{err: slclient.ErrNoCredentials},
+				fakeCredentialProvider{credentials: slclient.Credentials{Username: "chain-username", ApiKey: "chain-api-key"}},
+			)
+
+			_, _, err := newHttpClient(authenticator).DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			req.SetBasicAuth("chain-username", "chain-api-key")
+			Expect(authorizations).To(Equal([]string{req.Header.Get("Authorization")}))
+		})
+
+		It("fails when no provider has credentials", func() {
+			authenticator := slclient.NewCredentialChainAuthenticator(fakeCredentialProvider{err: slclient.ErrNoCredentials})
+
+			_, _, err := newHttpClient(authenticator).DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).To(Equal(slclient.ErrNoCredentials))
+			Expect(authorizations).To(BeEmpty())
+		})
+	})
+})
+
+type fakeCredentialProvider struct {
+	credentials slclient.Credentials
+	err         error
+}
+
+func (p fakeCredentialProvider) Retrieve() (slclient.Credentials, error) {
+	return p.credentials, p.err
+}
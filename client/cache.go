@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DEFAULT_CACHE_TTLS are the read-only catalog and location calls cached by NewResponseCache, by method or by <service>#<method>
+var DEFAULT_CACHE_TTLS = map[string]time.Duration{
+	"SoftLayer_Product_Package#getAllObjects":      1 * time.Hour,
+	"SoftLayer_Product_Package#getItems":           1 * time.Hour,
+	"SoftLayer_Product_Package#getItemPrices":      1 * time.Hour,
+	"SoftLayer_Location_Datacenter#getDatacenters": 24 * time.Hour,
+	"SoftLayer_Location#getDatacenters":            24 * time.Hour,
+}
+
+type CachedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	ExpiresAt  time.Time   `json:"expiresAt"`
+}
+
+func (r *CachedResponse) IsExpired() bool {
+	return time.Now().After(r.ExpiresAt)
+}
+
+type CacheStore interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, response *CachedResponse) error
+	DeleteByPrefix(prefix string) error
+}
+
+// ResponseCache caches the successful responses of the GET calls having a TTL, keyed by their service, method,
+// URL (which holds the id, the object mask and the object filter) and body. Any other call to a service
+// invalidates its cached responses.
+type ResponseCache struct {
+	Store CacheStore
+
+	//By <service>#<method> (e.g. SoftLayer_Product_Package#getItems) or by method, the former taking precedence
+	TTLs map[string]time.Duration
+}
+
+// NewResponseCache caches the DEFAULT_CACHE_TTLS calls, overridden or completed by ttls, in store (in memory when nil)
+func NewResponseCache(store CacheStore, ttls map[string]time.Duration) *ResponseCache {
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+
+	cacheTTLs := map[string]time.Duration{}
+	for key, ttl := range DEFAULT_CACHE_TTLS {
+		cacheTTLs[key] = ttl
+	}
+	for key, ttl := range ttls {
+		cacheTTLs[key] = ttl
+	}
+
+	return &ResponseCache{
+		Store: store,
+		TTLs:  cacheTTLs,
+	}
+}
+
+func (c *ResponseCache) TTL(service string, method string) time.Duration {
+	if ttl, ok := c.TTLs[service+"#"+method]; ok {
+		return ttl
+	}
+
+	return c.TTLs[method]
+}
+
+// Invalidate drops the cached responses of a method, or of all the methods of the service when method is empty
+func (c *ResponseCache) Invalidate(service string, method string) error {
+	prefix := service + "#"
+	if method != "" {
+		prefix += method + "#"
+	}
+
+	return c.Store.DeleteByPrefix(prefix)
+}
+
+func (c *ResponseCache) Clear() error {
+	return c.Store.DeleteByPrefix("")
+}
+
+func (c *ResponseCache) Middleware() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			if call.Verb != "GET" {
+				response, err := next(ctx, call)
+				if err == nil && response != nil && response.StatusCode < 300 {
+					c.Invalidate(call.Service, "")
+				}
+
+				return response, err
+			}
+
+			ttl := c.TTL(call.Service, call.Method)
+			if ttl <= 0 {
+				return next(ctx, call)
+			}
+
+			key := cacheKey(call)
+			if cached, ok := c.Store.Get(key); ok && !cached.IsExpired() {
+				return &Response{StatusCode: cached.StatusCode, Header: cached.Header, Body: cached.Body}, nil
+			}
+
+			response, err := next(ctx, call)
+			if err == nil && response != nil && response.StatusCode == http.StatusOK {
+				c.Store.Set(key, &CachedResponse{
+					StatusCode: response.StatusCode,
+					Header:     response.Header,
+					Body:       response.Body,
+					ExpiresAt:  time.Now().Add(ttl),
+				})
+			}
+
+			return response, err
+		}
+	}
+}
+
+// WithCache serves the calls cached by cache, e.g. WithCache(NewResponseCache(nil, nil))
+func WithCache(cache *ResponseCache) Option {
+	return WithMiddlewares(cache.Middleware())
+}
+
+type MemoryCacheStore struct {
+	responses map[string]*CachedResponse
+	mutex     sync.RWMutex
+}
+
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{
+		responses: map[string]*CachedResponse{},
+	}
+}
+
+func (s *MemoryCacheStore) Get(key string) (*CachedResponse, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	response, ok := s.responses[key]
+	return response, ok
+}
+
+func (s *MemoryCacheStore) Set(key string, response *CachedResponse) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.responses[key] = response
+	return nil
+}
+
+func (s *MemoryCacheStore) DeleteByPrefix(prefix string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key := range s.responses {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.responses, key)
+		}
+	}
+
+	return nil
+}
+
+// DiskCacheStore keeps one JSON file per response in Dir, so that the cache survives restarts.
+// Responses are account specific: do not share the directory between accounts.
+type DiskCacheStore struct {
+	Dir string
+
+	mutex sync.Mutex
+}
+
+func NewDiskCacheStore(dir string) (*DiskCacheStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &DiskCacheStore{Dir: dir}, nil
+}
+
+func (s *DiskCacheStore) Get(key string) (*CachedResponse, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bytes, err := ioutil.ReadFile(s.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	response := &CachedResponse{}
+	if err := json.Unmarshal(bytes, response); err != nil {
+		return nil, false
+	}
+
+	return response, true
+}
+
+func (s *DiskCacheStore) Set(key string, response *CachedResponse) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path(key), bytes, 0600)
+}
+
+func (s *DiskCacheStore) DeleteByPrefix(prefix string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	files, err := ioutil.ReadDir(s.Dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if strings.HasPrefix(file.Name(), cacheFileName(prefix)) && strings.HasSuffix(file.Name(), ".json") {
+			if err := os.Remove(filepath.Join(s.Dir, file.Name())); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Private methods
+
+func (s *DiskCacheStore) path(key string) string {
+	return filepath.Join(s.Dir, cacheFileName(key)+".json")
+}
+
+// Private functions
+
+// cacheKey is <service>#<method>#<hash of the HTTP method, URL and body>
+func cacheKey(call *Call) string {
+	hash := sha256.New()
+	hash.Write([]byte(call.HttpMethod + " " + call.Url + "\n"))
+	hash.Write(call.Body)
+
+	return call.Service + "#" + call.Method + "#" + hex.EncodeToString(hash.Sum(nil))
+}
+
+func cacheFileName(key string) string {
+	return strings.Replace(key, "#", ".", -1)
+}
@@ -0,0 +1,172 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("ResponseCache", func() {
+	var (
+		server   *httptest.Server
+		requests []string
+	)
+
+	BeforeEach(func() {
+		requests = []string{}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.Write([]byte(`[{"id":1,"item":{"id":2,"description":"fake-description","capacity":"100"}}]`))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newSoftLayerClient := func(cache *slclient.ResponseCache) *slclient.SoftLayerClient {
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+			slclient.WithBaseUrl(server.URL),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithCache(cache),
+		)
+	}
+
+	Context("in memory", func() {
+		var (
+			cache  *slclient.ResponseCache
+			client *slclient.SoftLayerClient
+		)
+
+		BeforeEach(func() {
+			cache = slclient.NewResponseCache(nil, nil)
+			client = newSoftLayerClient(cache)
+		})
+
+		It("serves the catalog calls from the cache", func() {
+			productPackageService, err := client.GetSoftLayer_Product_Package_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				itemPrices, err := productPackageService.GetItemPrices(46)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(itemPrices).To(HaveLen(1))
+				Expect(itemPrices[0].Item.Description).To(Equal("fake-description"))
+			}
+
+			Expect(requests).To(Equal([]string{"GET /SoftLayer_Product_Package/46/getItemPrices.json"}))
+
+			_, err = productPackageService.GetItemPrices(47)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+		})
+
+		It("does not cache the calls without a TTL", func() {
+			for i := 0; i < 2; i++ {
+				_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/getObject.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(requests).To(HaveLen(2))
+		})
+
+		It("uses the per method TTLs", func() {
+			cache.TTLs["getObject"] = 1 * time.Hour
+			cache.TTLs["SoftLayer_Product_Package#getItemPrices"] = 0
+
+			for i := 0; i < 2; i++ {
+				_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/getObject.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+				_, _, err = client.GetHttpClient().DoRawHttpRequest("SoftLayer_Product_Package/46/getItemPrices.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(requests).To(Equal([]string{
+				"GET /SoftLayer_Virtual_Guest/1234/getObject.json",
+				"GET /SoftLayer_Product_Package/46/getItemPrices.json",
+				"GET /SoftLayer_Product_Package/46/getItemPrices.json",
+			}))
+		})
+
+		It("expires the responses after their TTL", func() {
+			cache.TTLs["getItemPrices"] = 10 * time.Millisecond
+			delete(cache.TTLs, "SoftLayer_Product_Package#getItemPrices")
+
+			_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Product_Package/46/getItemPrices.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			time.Sleep(20 * time.Millisecond)
+			_, _, err = client.GetHttpClient().DoRawHttpRequest("SoftLayer_Product_Package/46/getItemPrices.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests).To(HaveLen(2))
+		})
+
+		It("invalidates explicitly and on the other calls to the service", func() {
+			get := func() {
+				_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Product_Package/46/getItems.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			get()
+			get()
+			Expect(requests).To(HaveLen(1))
+
+			Expect(cache.Invalidate("SoftLayer_Product_Package", "getItems")).To(Succeed())
+			get()
+			Expect(requests).To(HaveLen(2))
+
+			_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Product_Package/46/editObject.json", "PUT", nil)
+			Expect(err).ToNot(HaveOccurred())
+			get()
+			Expect(requests).To(HaveLen(4))
+
+			Expect(cache.Clear()).To(Succeed())
+			get()
+			Expect(requests).To(HaveLen(5))
+		})
+	})
+
+	Context("on disk", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "softlayer-go-cache")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("keeps the responses across clients", func() {
+			for i := 0; i < 2; i++ {
+				store, err := slclient.NewDiskCacheStore(dir)
+				Expect(err).ToNot(HaveOccurred())
+
+				client := newSoftLayerClient(slclient.NewResponseCache(store, nil))
+				responseBody, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Product_Package/46/getItems.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(responseBody)).To(ContainSubstring("fake-description"))
+			}
+
+			Expect(requests).To(HaveLen(1))
+
+			store, err := slclient.NewDiskCacheStore(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(slclient.NewResponseCache(store, nil).Invalidate("SoftLayer_Product_Package", "")).To(Succeed())
+
+			files, err := ioutil.ReadDir(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	CASSETTE_MODE_RECORD = "record"
+	CASSETTE_MODE_REPLAY = "replay"
+)
+
+// Response headers which are never recorded
+var CASSETTE_REDACTED_HEADERS = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Www-Authenticate",
+}
+
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   string `json:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+
+	replayed bool
+}
+
+// CassetteMatcher tells whether a recorded request can be replayed for a request
+type CassetteMatcher func(recorded CassetteRequest, request CassetteRequest) bool
+
+// Cassette holds the request/response pairs recorded by a client, see WithCassetteRecording and WithCassetteReplay.
+// Credentials are not recorded, the passwords and API keys of the request and response bodies are redacted and the
+// CASSETTE_REDACTED_HEADERS are dropped. The cassette files are only readable by their owner.
+type Cassette struct {
+	Path         string         `json:"-"`
+	Interactions []*Interaction `json:"interactions"`
+
+	//MatchMethodPathAndBody when nil
+	Matcher CassetteMatcher `json:"-"`
+
+	mutex sync.Mutex
+}
+
+func NewCassette(path string) *Cassette {
+	return &Cassette{
+		Path:         path,
+		Interactions: []*Interaction{},
+	}
+}
+
+func LoadCassette(path string) (*Cassette, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := NewCassette(path)
+	if err := json.Unmarshal(bytes, cassette); err != nil {
+		return nil, fmt.Errorf("softlayer-go: invalid cassette '%s': %s", path, err.Error())
+	}
+
+	return cassette, nil
+}
+
+func (c *Cassette) Save() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.save()
+}
+
+func (c *Cassette) Record(call *Call, response *Response) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.Interactions = append(c.Interactions, &Interaction{
+		Request: cassetteRequest(call),
+		Response: CassetteResponse{
+			StatusCode: response.StatusCode,
+			Header:     cassetteHeader(response.Header),
+			Body:       Redact(string(response.Body)),
+		},
+	})
+
+	return c.save()
+}
+
+// Replay returns the first interaction matching the call not replayed yet, or the last matching one when they all were,
+// so that a polling loop gets the recorded sequence of responses
+func (c *Cassette) Replay(call *Call) (*Interaction, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	matcher := c.Matcher
+	if matcher == nil {
+		matcher = MatchMethodPathAndBody
+	}
+
+	request := cassetteRequest(call)
+
+	var lastMatch *Interaction
+	for _, interaction := range c.Interactions {
+		if !matcher(interaction.Request, request) {
+			continue
+		}
+
+		if !interaction.replayed {
+			interaction.replayed = true
+			return interaction, true
+		}
+
+		lastMatch = interaction
+	}
+
+	return lastMatch, lastMatch != nil
+}
+
+// RecordingMiddleware sends the calls and records their responses
+func (c *Cassette) RecordingMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			response, err := next(ctx, call)
+			if err != nil || response == nil {
+				return response, err
+			}
+
+			if err := c.Record(call, response); err != nil {
+				return response, err
+			}
+
+			return response, nil
+		}
+	}
+}
+
+// ReplayMiddleware answers the calls from the cassette, without sending them
+func (c *Cassette) ReplayMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			interaction, ok := c.Replay(call)
+			if !ok {
+				return nil, fmt.Errorf("softlayer-go: no interaction of cassette '%s' matches %s %s", c.Path, call.HttpMethod, cassettePath(call.Url))
+			}
+
+			return &Response{
+				StatusCode: interaction.Response.StatusCode,
+				Header:     interaction.Response.Header,
+				Body:       []byte(interaction.Response.Body),
+			}, nil
+		}
+	}
+}
+
+func MatchMethodPathAndBody(recorded CassetteRequest, request CassetteRequest) bool {
+	return MatchMethodAndPath(recorded, request) && recorded.Body == request.Body
+}
+
+// MatchMethodAndPath ignores the bodies, e.g. when they hold generated SSH keys or hostnames
+func MatchMethodAndPath(recorded CassetteRequest, request CassetteRequest) bool {
+	return recorded.Method == request.Method && recorded.Path == request.Path
+}
+
+// WithCassetteRecording sends the calls to SoftLayer and records them in the cassette
+func WithCassetteRecording(cassette *Cassette) Option {
+	return WithMiddlewares(cassette.RecordingMiddleware())
+}
+
+// WithCassetteReplay answers the calls from the cassette, nothing is sent to SoftLayer
+func WithCassetteReplay(cassette *Cassette) Option {
+	return WithMiddlewares(cassette.ReplayMiddleware())
+}
+
+// WithCassette records the calls to the cassette file in CASSETTE_MODE_RECORD, or replays them from it in CASSETTE_MODE_REPLAY
+func WithCassette(cassette *Cassette, mode string) Option {
+	if mode == CASSETTE_MODE_RECORD {
+		return WithCassetteRecording(cassette)
+	}
+
+	return WithCassetteReplay(cassette)
+}
+
+// Private methods
+
+func (c *Cassette) save() error {
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(c.Path, bytes, 0600); err != nil {
+		return err
+	}
+
+	return os.Chmod(c.Path, 0600)
+}
+
+// Private functions
+
+func cassetteRequest(call *Call) CassetteRequest {
+	return CassetteRequest{
+		Method: call.HttpMethod,
+		Path:   cassettePath(call.Url),
+		Body:   cassetteBody(call.Body),
+	}
+}
+
+// cassettePath keeps the SoftLayer part of the URL, so that cassettes do not depend on the endpoint
+func cassettePath(requestUrl string) string {
+	path := requestUrl
+	if parsedUrl, err := url.Parse(requestUrl); err == nil {
+		path = parsedUrl.RequestURI()
+	}
+
+	if index := strings.Index(path, "/SoftLayer_"); index >= 0 {
+		return path[index:]
+	}
+
+	return path
+}
+
+func cassetteBody(body []byte) string {
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, body); err == nil {
+		body = compacted.Bytes()
+	}
+
+	return Redact(strings.TrimSpace(string(body)))
+}
+
+func cassetteHeader(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+
+	recorded := http.Header{}
+	for name, values := range header {
+		if !containsString(CASSETTE_REDACTED_HEADERS, name) {
+			recorded[name] = values
+		}
+	}
+
+	return recorded
+}
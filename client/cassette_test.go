@@ -0,0 +1,200 @@
+package client_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		server       *httptest.Server
+		requests     int
+		powerStates  []string
+		cassettePath string
+		dir          string
+	)
+
+	BeforeEach(func() {
+		requests = 0
+		powerStates = []string{"HALTED", "RUNNING"}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.Method == "POST" {
+				w.Write([]byte(`true`))
+				return
+			}
+
+			powerState := powerStates[len(powerStates)-1]
+			if requests <= len(powerStates) {
+				powerState = powerStates[requests-1]
+			}
+
+			w.Header().Set(slclient.SOFTLAYER_TOTAL_ITEMS_HEADER, "1")
+			w.Header().Set("Set-Cookie", "session=fake-session")
+			w.Write([]byte(`{"keyName":"` + powerState + `","name":"` + powerState + `","password":"fake-password"}`))
+		}))
+
+		var err error
+		dir, err = ioutil.TempDir("", "softlayer-go-cassette")
+		Expect(err).ToNot(HaveOccurred())
+
+		cassettePath = filepath.Join(dir, "cassettes", "virtual_guest.json")
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	record := func() {
+		client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+			slclient.WithBaseUrl(server.URL),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithCassetteRecording(slclient.NewCassette(cassettePath)),
+		)
+
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, expectedPowerState := range powerStates {
+			powerState, err := virtualGuestService.GetPowerState(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState.KeyName).To(Equal(expectedPowerState))
+		}
+
+		_, err = virtualGuestService.SetTags(1234, []string{"tag1"})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newReplayClient := func() *slclient.SoftLayerClient {
+		cassette, err := slclient.LoadCassette(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+
+		return slclient.NewSoftLayerClient("other-username", "other-api-key",
+			slclient.WithBaseUrl("http://localhost:1/rest/v3"),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithCassetteReplay(cassette),
+		)
+	}
+
+	It("records the interactions without the credentials", func() {
+		record()
+		Expect(requests).To(Equal(3))
+
+		cassette, err := slclient.LoadCassette(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cassette.Interactions).To(HaveLen(3))
+
+		Expect(cassette.Interactions[0].Request).To(Equal(slclient.CassetteRequest{Method: "GET", Path: "/SoftLayer_Virtual_Guest/1234/getPowerState.json"}))
+		Expect(cassette.Interactions[0].Response.StatusCode).To(Equal(200))
+		Expect(cassette.Interactions[0].Response.Body).To(ContainSubstring("HALTED"))
+		Expect(cassette.Interactions[2].Request.Method).To(Equal("POST"))
+		Expect(cassette.Interactions[2].Request.Body).To(Equal(`{"parameters":["tag1"]}`))
+
+		bytes, err := ioutil.ReadFile(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(bytes)).ToNot(ContainSubstring("fake-api-key"))
+		Expect(string(bytes)).ToNot(ContainSubstring("ZmFrZS11c2VybmFtZTpmYWtlLWFwaS1rZXk="))
+	})
+
+	It("redacts the responses and writes the cassette for its owner only", func() {
+		record()
+
+		cassette, err := slclient.LoadCassette(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cassette.Interactions[0].Response.Body).To(ContainSubstring(`"password":"******"`))
+		Expect(cassette.Interactions[0].Response.Header.Get(slclient.SOFTLAYER_TOTAL_ITEMS_HEADER)).To(Equal("1"))
+		Expect(cassette.Interactions[0].Response.Header.Get("Set-Cookie")).To(BeEmpty())
+
+		bytes, err := ioutil.ReadFile(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(bytes)).ToNot(ContainSubstring("fake-password"))
+		Expect(string(bytes)).ToNot(ContainSubstring("fake-session"))
+
+		info, err := os.Stat(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("redacts the API key of the XML-RPC requests", func() {
+		cassette := slclient.NewCassette(cassettePath)
+		xmlRpcClient := slclient.NewXmlRpcClientWithOptions(slclient.NewBasicAuthenticator("fake-username", "fake-api-key"), "templates",
+			slclient.WithBaseUrl(server.URL),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithCassetteRecording(cassette),
+		)
+
+		xmlRpcClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/getPowerState.json", "GET", nil)
+		Expect(cassette.Interactions).To(HaveLen(1))
+
+		bytes, err := ioutil.ReadFile(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(bytes)).To(ContainSubstring("apiKey"))
+		Expect(string(bytes)).ToNot(ContainSubstring("fake-api-key"))
+	})
+
+	It("replays the interactions in order without sending them", func() {
+		record()
+		requests = 0
+
+		virtualGuestService, err := newReplayClient().GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, expectedPowerState := range []string{"HALTED", "RUNNING", "RUNNING"} {
+			powerState, err := virtualGuestService.GetPowerState(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState.KeyName).To(Equal(expectedPowerState))
+		}
+
+		tagged, err := virtualGuestService.SetTags(1234, []string{"tag1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tagged).To(BeTrue())
+
+		Expect(requests).To(Equal(0))
+	})
+
+	It("replays the response headers", func() {
+		record()
+
+		_, errorCode, totalItems, err := newReplayClient().GetHttpClient().DoPagedRequest(softlayer.NewRequest("SoftLayer_Virtual_Guest", "getPowerState").WithId(1234))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(errorCode).To(Equal(200))
+		Expect(totalItems).To(Equal(1))
+	})
+
+	It("fails the requests matching no interaction", func() {
+		record()
+
+		client := newReplayClient()
+		_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/5678/getPowerState.json", "GET", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("/SoftLayer_Virtual_Guest/5678/getPowerState.json"))
+
+		_, _, err = client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/setTags.json", "POST", bytes.NewBufferString(`{"parameters":["tag2"]}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("matches by method and path only with MatchMethodAndPath", func() {
+		record()
+
+		cassette, err := slclient.LoadCassette(cassettePath)
+		Expect(err).ToNot(HaveOccurred())
+		cassette.Matcher = slclient.MatchMethodAndPath
+
+		client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithLogger(slclient.NewNopLogger()), slclient.WithCassette(cassette, slclient.CASSETTE_MODE_REPLAY))
+		_, errorCode, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/setTags.json", "POST", bytes.NewBufferString(`{"parameters":["tag2"]}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(errorCode).To(Equal(200))
+	})
+})
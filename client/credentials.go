@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	SL_USERNAME_ENV = "SL_USERNAME"
+	SL_API_KEY_ENV  = "SL_API_KEY"
+
+	SOFTLAYER_CONFIG_FILE_NAME = ".softlayer"
+	SOFTLAYER_CONFIG_SECTION   = "softlayer"
+)
+
+var ErrNoCredentials = errors.New("softlayer-go: no SoftLayer credentials found, set SL_USERNAME and SL_API_KEY or create ~/.softlayer")
+
+type Credentials struct {
+	Username string
+	ApiKey   string
+}
+
+func (c Credentials) IsValid() bool {
+	return c.Username != "" && c.ApiKey != ""
+}
+
+type CredentialProvider interface {
+	//Returns ErrNoCredentials when the provider has no credentials to offer
+	Retrieve() (Credentials, error)
+}
+
+type EnvCredentialProvider struct{}
+
+func (p EnvCredentialProvider) Retrieve() (Credentials, error) {
+	credentials := Credentials{
+		Username: os.Getenv(SL_USERNAME_ENV),
+		ApiKey:   os.Getenv(SL_API_KEY_ENV),
+	}
+
+	if !credentials.IsValid() {
+		return Credentials{}, ErrNoCredentials
+	}
+
+	return credentials, nil
+}
+
+// ConfigFileCredentialProvider reads the username and api_key of the [softlayer] section of a config file, e.g.
+//
+//	[softlayer]
+//	username = my-username
+//	api_key = my-api-key
+type ConfigFileCredentialProvider struct {
+	Path string
+}
+
+func NewConfigFileCredentialProvider() ConfigFileCredentialProvider {
+	return ConfigFileCredentialProvider{Path: DefaultConfigFilePath()}
+}
+
+func (p ConfigFileCredentialProvider) Retrieve() (Credentials, error) {
+	if p.Path == "" {
+		return Credentials{}, ErrNoCredentials
+	}
+
+	file, err := os.Open(p.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Credentials{}, ErrNoCredentials
+		}
+
+		return Credentials{}, err
+	}
+	defer file.Close()
+
+	credentials := Credentials{}
+	section := ""
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.ToLower(strings.TrimSpace(line[1 : len(line)-1]))
+			continue
+		}
+
+		if section != SOFTLAYER_CONFIG_SECTION {
+			continue
+		}
+
+		key, value, ok := configKeyValue(line)
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "username":
+			credentials.Username = value
+		case "api_key":
+			credentials.ApiKey = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Credentials{}, fmt.Errorf("softlayer-go: reading config file '%s': %s", p.Path, err.Error())
+	}
+
+	if !credentials.IsValid() {
+		return Credentials{}, ErrNoCredentials
+	}
+
+	return credentials, nil
+}
+
+type ChainCredentialProvider struct {
+	Providers []CredentialProvider
+}
+
+func NewChainCredentialProvider(providers ...CredentialProvider) ChainCredentialProvider {
+	return ChainCredentialProvider{Providers: providers}
+}
+
+// Retrieve returns the credentials of the first provider offering some, errors other than ErrNoCredentials stop the chain
+func (p ChainCredentialProvider) Retrieve() (Credentials, error) {
+	for _, provider := range p.Providers {
+		credentials, err := provider.Retrieve()
+		if err == nil {
+			return credentials, nil
+		}
+
+		if err != ErrNoCredentials {
+			return Credentials{}, err
+		}
+	}
+
+	return Credentials{}, ErrNoCredentials
+}
+
+// DefaultCredentialProviders looks up the SL_USERNAME and SL_API_KEY environment variables first, then ~/.softlayer
+func DefaultCredentialProviders() []CredentialProvider {
+	return []CredentialProvider{
+		EnvCredentialProvider{},
+		NewConfigFileCredentialProvider(),
+	}
+}
+
+func DefaultConfigFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, SOFTLAYER_CONFIG_FILE_NAME)
+}
+
+// Private functions
+
+func configKeyValue(line string) (string, string, bool) {
+	separator := strings.IndexAny(line, "=:")
+	if separator < 0 {
+		return "", "", false
+	}
+
+	key := strings.ToLower(strings.TrimSpace(line[:separator]))
+	value := strings.Trim(strings.TrimSpace(line[separator+1:]), `"'`)
+
+	return key, value, true
+}
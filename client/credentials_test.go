@@ -0,0 +1,126 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("Credentials", func() {
+	var (
+		tmpDir string
+
+		username string
+		apiKey   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "softlayer-go-credentials")
+		Expect(err).ToNot(HaveOccurred())
+
+		username = os.Getenv("SL_USERNAME")
+		apiKey = os.Getenv("SL_API_KEY")
+	})
+
+	AfterEach(func() {
+		os.Setenv("SL_USERNAME", username)
+		os.Setenv("SL_API_KEY", apiKey)
+
+		os.RemoveAll(tmpDir)
+	})
+
+	writeConfigFile := func(contents string) string {
+		path := filepath.Join(tmpDir, ".softlayer")
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+
+		return path
+	}
+
+	Context("EnvCredentialProvider", func() {
+		It("reads SL_USERNAME and SL_API_KEY", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+			os.Setenv("SL_API_KEY", "env-api-key")
+
+			credentials, err := slclient.EnvCredentialProvider{}.Retrieve()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials).To(Equal(slclient.Credentials{Username: "env-username", ApiKey: "env-api-key"}))
+		})
+
+		It("returns ErrNoCredentials when a variable is missing", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+			os.Setenv("SL_API_KEY", "")
+
+			_, err := slclient.EnvCredentialProvider{}.Retrieve()
+			Expect(err).To(Equal(slclient.ErrNoCredentials))
+		})
+	})
+
+	Context("ConfigFileCredentialProvider", func() {
+		It("reads the username and api_key of the softlayer section", func() {
+			path := writeConfigFile(`
+# softlayer-go test config
+[other]
+username = other-username
+
+[softlayer]
+username = file-username
+api_key: "file-api-key"
+endpoint_url = https://api.softlayer.com/rest/v3.1/
+`)
+
+			credentials, err := slclient.ConfigFileCredentialProvider{Path: path}.Retrieve()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials).To(Equal(slclient.Credentials{Username: "file-username", ApiKey: "file-api-key"}))
+		})
+
+		It("returns ErrNoCredentials when the file does not exist", func() {
+			_, err := slclient.ConfigFileCredentialProvider{Path: filepath.Join(tmpDir, "missing")}.Retrieve()
+			Expect(err).To(Equal(slclient.ErrNoCredentials))
+		})
+
+		It("returns ErrNoCredentials when the softlayer section is incomplete", func() {
+			path := writeConfigFile("[softlayer]\nusername = file-username\n")
+
+			_, err := slclient.ConfigFileCredentialProvider{Path: path}.Retrieve()
+			Expect(err).To(Equal(slclient.ErrNoCredentials))
+		})
+	})
+
+	Context("#NewSoftLayerClientFromCredentials", func() {
+		It("prefers the environment variables to the config file", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+			os.Setenv("SL_API_KEY", "env-api-key")
+			path := writeConfigFile("[softlayer]\nusername = file-username\napi_key = file-api-key\n")
+
+			client, err := slclient.NewSoftLayerClientFromCredentials([]slclient.CredentialProvider{slclient.EnvCredentialProvider{}, slclient.ConfigFileCredentialProvider{Path: path}})
+			Expect(err).ToNot(HaveOccurred())
+
+			httpClient := client.GetHttpClient().(*slclient.HttpClient)
+			Expect(httpClient.Authenticator).To(Equal(slclient.NewBasicAuthenticator("env-username", "env-api-key")))
+		})
+
+		It("falls back to the config file", func() {
+			os.Setenv("SL_USERNAME", "")
+			path := writeConfigFile("[softlayer]\nusername = file-username\napi_key = file-api-key\n")
+
+			client, err := slclient.NewSoftLayerClientFromCredentials([]slclient.CredentialProvider{slclient.EnvCredentialProvider{}, slclient.ConfigFileCredentialProvider{Path: path}})
+			Expect(err).ToNot(HaveOccurred())
+
+			httpClient := client.GetHttpClient().(*slclient.HttpClient)
+			Expect(httpClient.Authenticator).To(Equal(slclient.NewBasicAuthenticator("file-username", "file-api-key")))
+		})
+
+		It("fails without any credentials", func() {
+			os.Setenv("SL_USERNAME", "")
+
+			_, err := slclient.NewSoftLayerClientFromCredentials([]slclient.CredentialProvider{slclient.EnvCredentialProvider{}, slclient.ConfigFileCredentialProvider{Path: filepath.Join(tmpDir, "missing")}})
+			Expect(err).To(Equal(slclient.ErrNoCredentials))
+		})
+	})
+})
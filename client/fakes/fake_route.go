@@ -0,0 +1,95 @@
+package client_fakes
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// FakeCall is a call received by the FakeHttpClient
+type FakeCall struct {
+	RequestType string
+	Path        string
+	Masks       []string
+	Filters     string
+	Body        string
+}
+
+type FakeResponse struct {
+	Body       []byte
+	StatusCode int
+	Error      error
+}
+
+// FakeRoute answers the calls matching its request type and path pattern with its responses in order, the last one
+// answering the calls once they all were returned, e.g. for the polling of an order
+type FakeRoute struct {
+	//Matches any request type when empty
+	RequestType string
+	Pattern     *regexp.Regexp
+	Responses   []FakeResponse
+
+	//Directory of the fixtures of RespondWithFixture
+	FixturesPath string
+
+	index int
+}
+
+// Respond adds a 200 response with the body to the route
+func (fr *FakeRoute) Respond(body []byte) *FakeRoute {
+	return fr.RespondWithStatus(200, body)
+}
+
+func (fr *FakeRoute) RespondWithStatus(statusCode int, body []byte) *FakeRoute {
+	fr.Responses = append(fr.Responses, FakeResponse{Body: body, StatusCode: statusCode})
+	return fr
+}
+
+func (fr *FakeRoute) RespondWithError(err error) *FakeRoute {
+	fr.Responses = append(fr.Responses, FakeResponse{StatusCode: 520, Error: err})
+	return fr
+}
+
+// RespondWithFixture adds 200 responses with the content of the fixture files of FixturesPath, e.g.
+// "SoftLayer_Virtual_Guest_Service_getPowerState.json"
+func (fr *FakeRoute) RespondWithFixture(fileNames ...string) *FakeRoute {
+	for _, fileName := range fileNames {
+		body, err := ioutil.ReadFile(filepath.Join(fr.FixturesPath, fileName))
+		if err != nil {
+			fr.RespondWithError(err)
+			continue
+		}
+
+		fr.Respond(body)
+	}
+
+	return fr
+}
+
+func (fr *FakeRoute) Matches(requestType string, path string) bool {
+	return (fr.RequestType == "" || fr.RequestType == requestType) && fr.Pattern.MatchString(path)
+}
+
+// Private methods
+
+func (fr *FakeRoute) nextResponse() FakeResponse {
+	if len(fr.Responses) == 0 {
+		return FakeResponse{StatusCode: 200}
+	}
+
+	response := fr.Responses[fr.index]
+	if fr.index < len(fr.Responses)-1 {
+		fr.index++
+	}
+
+	return response
+}
+
+// Private functions
+
+// defaultFixturesPath is test_fixtures/services seen from the package under test, as testhelpers.ReadJsonTestFixtures does
+func defaultFixturesPath() string {
+	wd, _ := os.Getwd()
+	return filepath.Join(wd, "..", "test_fixtures", "services")
+}
@@ -2,6 +2,11 @@ package client_fakes
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
 type FakeHttpClient struct {
@@ -15,6 +20,16 @@ type FakeHttpClient struct {
 	DoRawHttpRequestResponsesCount int
 	DoRawHttpRequestResponsesIndex int
 
+	//Routed responses, see Route; the calls matching no route get the DoRawHttpRequest* responses
+	Routes       []*FakeRoute
+	FixturesPath string
+
+	//Every call received, in order
+	Calls []FakeCall
+
+	//Context passed to the last DoRawHttpRequest* call
+	DoRawHttpRequestContext context.Context
+
 	//DoRawHttpRequest
 	DoRawHttpRequestPath        string
 	DoRawHttpRequestRequestType string
@@ -39,6 +54,13 @@ type FakeHttpClient struct {
 	DoRawHttpRequestWithObjectFilterAndObjectMaskRequestType string
 	DoRawHttpRequestWithObjectFilterAndObjectMaskRequestBody *bytes.Buffer
 
+	//DoRequest
+	DoRequestRequest *softlayer.Request
+
+	//DoPagedRequest
+	DoPagedRequestRequests   []softlayer.Request
+	DoPagedRequestTotalItems int
+
 	//GenerateRequest
 	GenerateRequestBodyTemplateData interface{}
 	GenerateRequestBodyBuffer       *bytes.Buffer
@@ -63,45 +85,129 @@ func NewFakeHttpClient(username, Password string) *FakeHttpClient {
 		DoRawHttpRequestResponses:      [][]byte{},
 		DoRawHttpRequestResponsesCount: 0,
 		DoRawHttpRequestResponsesIndex: 0,
+
+		DoPagedRequestTotalItems: -1,
+
+		Routes:       []*FakeRoute{},
+		FixturesPath: defaultFixturesPath(),
+		Calls:        []FakeCall{},
 	}
 }
 
+// Route answers the calls of the request type ("" for any) whose path fully matches the regular expression, e.g.
+//
+//	fakeHttpClient.Route("GET", `SoftLayer_Virtual_Guest/\d+/getPowerState\.json`).RespondWithFixture("SoftLayer_Virtual_Guest_Service_getPowerState.json")
+func (fhc *FakeHttpClient) Route(requestType string, pathPattern string) *FakeRoute {
+	route := &FakeRoute{
+		RequestType:  requestType,
+		Pattern:      regexp.MustCompile("^(?:" + pathPattern + ")$"),
+		Responses:    []FakeResponse{},
+		FixturesPath: fhc.FixturesPath,
+	}
+
+	fhc.Routes = append(fhc.Routes, route)
+
+	return route
+}
+
+// CallsTo returns the calls of the request type ("" for any) whose path fully matches the regular expression
+func (fhc *FakeHttpClient) CallsTo(requestType string, pathPattern string) []FakeCall {
+	pattern := regexp.MustCompile("^(?:" + pathPattern + ")$")
+
+	calls := []FakeCall{}
+	for _, call := range fhc.Calls {
+		if (requestType == "" || call.RequestType == requestType) && pattern.MatchString(call.Path) {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
 //softlayer.HttpClient interface methods
 
 func (fhc *FakeHttpClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return fhc.DoRawHttpRequestWithContext(context.Background(), path, requestType, requestBody)
+}
+
+func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return fhc.DoRawHttpRequestWithObjectMaskWithContext(context.Background(), path, masks, requestType, requestBody)
+}
+
+func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return fhc.DoRawHttpRequestWithObjectFilterWithContext(context.Background(), path, filters, requestType, requestBody)
+}
+
+func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return fhc.DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(context.Background(), path, masks, filters, requestType, requestBody)
+}
+
+func (fhc *FakeHttpClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	fhc.DoRawHttpRequestContext = ctx
 	fhc.DoRawHttpRequestPath = path
 	fhc.DoRawHttpRequestRequestType = requestType
 	fhc.DoRawHttpRequestRequestBody = requestBody
 
-	return fhc.processResponse()
+	return fhc.processResponse(ctx, newFakeCall(requestType, path, nil, "", requestBody))
 }
 
-func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	fhc.DoRawHttpRequestContext = ctx
 	fhc.DoRawHttpRequestWithObjectMaskPath = path
 	fhc.DoRawHttpRequestWithObjectMaskMasks = masks
 	fhc.DoRawHttpRequestWithObjectMaskRequestType = requestType
 	fhc.DoRawHttpRequestWithObjectMaskRequestBody = requestBody
 
-	return fhc.processResponse()
+	return fhc.processResponse(ctx, newFakeCall(requestType, path, masks, "", requestBody))
 }
 
-func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectFilterWithContext(ctx context.Context, path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	fhc.DoRawHttpRequestContext = ctx
 	fhc.DoRawHttpRequestWithObjectFilterPath = path
 	fhc.DoRawHttpRequestWithObjectFilterFilters = filters
 	fhc.DoRawHttpRequestWithObjectFilterRequestType = requestType
 	fhc.DoRawHttpRequestWithObjectFilterRequestBody = requestBody
 
-	return fhc.processResponse()
+	return fhc.processResponse(ctx, newFakeCall(requestType, path, nil, filters, requestBody))
 }
 
-func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+func (fhc *FakeHttpClient) DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx context.Context, path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	fhc.DoRawHttpRequestContext = ctx
 	fhc.DoRawHttpRequestWithObjectFilterAndObjectMaskPath = path
 	fhc.DoRawHttpRequestWithObjectFilterAndObjectMaskMasks = masks
 	fhc.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters = filters
 	fhc.DoRawHttpRequestWithObjectFilterAndObjectMaskRequestType = requestType
 	fhc.DoRawHttpRequestWithObjectFilterAndObjectMaskRequestBody = requestBody
 
-	return fhc.processResponse()
+	return fhc.processResponse(ctx, newFakeCall(requestType, path, masks, filters, requestBody))
+}
+
+func (fhc *FakeHttpClient) DoRequest(request *softlayer.Request) ([]byte, int, error) {
+	return fhc.DoRequestWithContext(context.Background(), request)
+}
+
+func (fhc *FakeHttpClient) DoRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, error) {
+	fhc.DoRawHttpRequestContext = ctx
+	fhc.DoRequestRequest = request
+
+	return fhc.processResponse(ctx, newFakeCallFromRequest(request))
+}
+
+func (fhc *FakeHttpClient) DoPagedRequest(request *softlayer.Request) ([]byte, int, int, error) {
+	return fhc.DoPagedRequestWithContext(context.Background(), request)
+}
+
+func (fhc *FakeHttpClient) DoPagedRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, int, error) {
+	fhc.DoRawHttpRequestContext = ctx
+	fhc.DoRequestRequest = request
+	fhc.DoPagedRequestRequests = append(fhc.DoPagedRequestRequests, *request)
+
+	response, errorCode, err := fhc.processResponse(ctx, newFakeCallFromRequest(request))
+	if err != nil || fhc.DoPagedRequestTotalItems >= 0 {
+		return response, errorCode, fhc.DoPagedRequestTotalItems, err
+	}
+
+	return paginate(response, request, errorCode)
 }
 
 func (fhc *FakeHttpClient) GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error) {
@@ -124,8 +230,20 @@ func (fhc *FakeHttpClient) CheckForHttpResponseErrors(data []byte) error {
 
 // private methods
 
-func (fhc *FakeHttpClient) processResponse() ([]byte, int, error) {
+func (fhc *FakeHttpClient) processResponse(ctx context.Context, call FakeCall) ([]byte, int, error) {
 	fhc.DoRawHttpRequestResponsesCount += 1
+	fhc.Calls = append(fhc.Calls, call)
+
+	if ctx.Err() != nil {
+		return []byte{}, 520, ctx.Err()
+	}
+
+	for _, route := range fhc.Routes {
+		if route.Matches(call.RequestType, call.Path) {
+			response := route.nextResponse()
+			return response.Body, response.StatusCode, response.Error
+		}
+	}
 
 	if fhc.DoRawHttpRequestError != nil {
 		return []byte{}, fhc.DoRawHttpRequestInt, fhc.DoRawHttpRequestError
@@ -138,3 +256,49 @@ func (fhc *FakeHttpClient) processResponse() ([]byte, int, error) {
 		return fhc.DoRawHttpRequestResponses[fhc.DoRawHttpRequestResponsesIndex-1], fhc.DoRawHttpRequestInt, fhc.DoRawHttpRequestError
 	}
 }
+
+// private functions
+
+func newFakeCall(requestType string, path string, masks []string, filters string, requestBody *bytes.Buffer) FakeCall {
+	body := ""
+	if requestBody != nil {
+		body = requestBody.String()
+	}
+
+	return FakeCall{
+		RequestType: requestType,
+		Path:        path,
+		Masks:       masks,
+		Filters:     filters,
+		Body:        body,
+	}
+}
+
+func newFakeCallFromRequest(request *softlayer.Request) FakeCall {
+	requestBody, _ := request.Body()
+
+	return newFakeCall(request.Verb(), request.Path(), request.Mask, request.Filter, requestBody)
+}
+
+// paginate returns the resultLimit/offset page of a JSON array response, like the SoftLayer API does
+func paginate(response []byte, request *softlayer.Request, errorCode int) ([]byte, int, int, error) {
+	items := []json.RawMessage{}
+	if err := json.Unmarshal(response, &items); err != nil {
+		return response, errorCode, -1, nil
+	}
+
+	start, end := request.Offset, len(items)
+	if start > len(items) {
+		start = len(items)
+	}
+	if request.ResultLimit > 0 && start+request.ResultLimit < end {
+		end = start + request.ResultLimit
+	}
+
+	page, err := json.Marshal(items[start:end])
+	if err != nil {
+		return nil, errorCode, -1, err
+	}
+
+	return page, errorCode, len(items), nil
+}
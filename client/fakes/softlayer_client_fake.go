@@ -1,11 +1,13 @@
 package client_fakes
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	slclient "github.com/maximilien/softlayer-go/client"
 	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
@@ -44,6 +46,18 @@ func NewFakeSoftLayerClient(username, apiKey string) *FakeSoftLayerClient {
 	return fslc
 }
 
+func (fslc *FakeSoftLayerClient) RegisterService(name string, factory softlayer.ServiceFactory) {
+	fslc.SoftLayerServices[name] = factory(fslc)
+}
+
+func (fslc *FakeSoftLayerClient) Call(service string, method string, id int, parameters []interface{}, result interface{}) error {
+	return softlayer.Call(fslc, service, method, id, parameters, result)
+}
+
+func (fslc *FakeSoftLayerClient) CallWithContext(ctx context.Context, service string, method string, id int, parameters []interface{}, result interface{}) error {
+	return softlayer.CallWithContext(ctx, fslc, service, method, id, parameters, result)
+}
+
 //softlayer.Client interface methods
 
 func (fslc *FakeSoftLayerClient) GetHttpClient() softlayer.HttpClient {
@@ -60,129 +74,59 @@ func (fslc *FakeSoftLayerClient) GetService(serviceName string) (softlayer.Servi
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Account_Service() (softlayer.SoftLayer_Account_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Account")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Account_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Account_Service](fslc, "SoftLayer_Account")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Virtual_Guest_Service() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Virtual_Guest")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Virtual_Guest_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Virtual_Guest_Service](fslc, "SoftLayer_Virtual_Guest")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Dns_Domain_Service() (softlayer.SoftLayer_Dns_Domain_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Dns_Domain")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Dns_Domain_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Dns_Domain_Service](fslc, "SoftLayer_Dns_Domain")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Virtual_Disk_Image_Service() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Virtual_Disk_Image")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Virtual_Disk_Image_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Virtual_Disk_Image_Service](fslc, "SoftLayer_Virtual_Disk_Image")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Security_Ssh_Key_Service() (softlayer.SoftLayer_Security_Ssh_Key_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Security_Ssh_Key")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Security_Ssh_Key_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Security_Ssh_Key_Service](fslc, "SoftLayer_Security_Ssh_Key")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Network_Storage_Service() (softlayer.SoftLayer_Network_Storage_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Network_Storage")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Network_Storage_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Network_Storage_Service](fslc, "SoftLayer_Network_Storage")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Network_Storage_Allowed_Host_Service() (softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Network_Storage_Allowed_Host")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Network_Storage_Allowed_Host_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Network_Storage_Allowed_Host_Service](fslc, "SoftLayer_Network_Storage_Allowed_Host")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Product_Order_Service() (softlayer.SoftLayer_Product_Order_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Product_Order")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Product_Order_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Product_Order_Service](fslc, "SoftLayer_Product_Order")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Product_Package_Service() (softlayer.SoftLayer_Product_Package_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Product_Package")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Product_Package_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Product_Package_Service](fslc, "SoftLayer_Product_Package")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Billing_Item_Cancellation_Request_Service() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Billing_Item_Cancellation_Request")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service](fslc, "SoftLayer_Billing_Item_Cancellation_Request")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Billing_Item_Service() (softlayer.SoftLayer_Billing_Item_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Billing_Item")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Billing_Item_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Billing_Item_Service](fslc, "SoftLayer_Billing_Item")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Virtual_Guest_Block_Device_Template_Group")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service](fslc, "SoftLayer_Virtual_Guest_Block_Device_Template_Group")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Hardware_Service() (softlayer.SoftLayer_Hardware_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Hardware")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Hardware_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Hardware_Service](fslc, "SoftLayer_Hardware")
 }
 
 func (fslc *FakeSoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, error) {
-	slService, err := fslc.GetService("SoftLayer_Dns_Domain_ResourceRecord")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service](fslc, "SoftLayer_Dns_Domain_ResourceRecord")
 }
 
 //Private methods
@@ -202,4 +146,10 @@ func (fslc *FakeSoftLayerClient) initSoftLayerServices() {
 	fslc.SoftLayerServices["SoftLayer_Hardware"] = services.NewSoftLayer_Hardware_Service(fslc)
 	fslc.SoftLayerServices["SoftLayer_Dns_Domain"] = services.NewSoftLayer_Dns_Domain_Service(fslc)
 	fslc.SoftLayerServices["SoftLayer_Dns_Domain_ResourceRecord"] = services.NewSoftLayer_Dns_Domain_ResourceRecord_Service(fslc)
+
+	fslc.initGeneratedSoftLayerServices()
+
+	for name, factory := range slclient.RegisteredServices() {
+		fslc.SoftLayerServices[name] = factory(fslc)
+	}
 }
@@ -2,32 +2,49 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
-	"os"
-	"path/filepath"
-	"regexp"
-	"text/template"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/maximilien/softlayer-go/common"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
-const NON_VERBOSE = "NON_VERBOSE"
+const (
+	NON_VERBOSE = "NON_VERBOSE"
+
+	SOFTLAYER_TOTAL_ITEMS_HEADER = "SoftLayer-Total-Items"
+)
 
 type HttpClient struct {
 	HTTPClient *http.Client
 
-	username string
-	password string
+	RetryPolicy RetryPolicy
+
+	Authenticator Authenticator
+
+	Logger Logger
+
+	//Wrapped around every call, the first one being the outermost, see Use
+	Middlewares []Middleware
+
+	//Optional, shared by all the requests of the client
+	RateLimiter        *RateLimiter
+	ConcurrencyLimiter *ConcurrencyLimiter
 
 	useHttps bool
 
 	apiUrl string
 
-	nonVerbose bool
-
 	templatePath string
 }
 
@@ -36,24 +53,46 @@ func NewHttpsClient(username, password, apiUrl, templatePath string) *HttpClient
 }
 
 func NewHttpClient(username, password, apiUrl, templatePath string, useHttps bool) *HttpClient {
-	pwd, err := os.Getwd()
-	if err != nil {
-		panic(err)
-	}
+	return NewHttpClientWithAuthenticator(NewBasicAuthenticator(username, password), apiUrl, templatePath, useHttps)
+}
+
+func NewHttpClientWithAuthenticator(authenticator Authenticator, apiUrl, templatePath string, useHttps bool) *HttpClient {
+	return NewHttpClientWithOptions(authenticator, templatePath, WithBaseUrl(apiUrl), WithHttps(useHttps))
+}
+
+// NewHttpClientWithOptions builds the request bodies of GenerateRequestBody with the template of templatePath, see
+// GenerateRequestBody
+func NewHttpClientWithOptions(authenticator Authenticator, templatePath string, options ...Option) *HttpClient {
+	o := NewOptions(options...)
 
 	hClient := &HttpClient{
-		username: username,
-		password: password,
+		Authenticator: authenticator,
+
+		useHttps: o.UseHttps,
 
-		useHttps: useHttps,
+		apiUrl: o.ApiUrl,
 
-		apiUrl: apiUrl,
+		templatePath: templatePath,
 
-		templatePath: filepath.Join(pwd, templatePath),
+		HTTPClient: o.httpClient(),
 
-		HTTPClient: http.DefaultClient,
+		RetryPolicy: DefaultRetryPolicy(),
 
-		nonVerbose: checkNonVerbose(),
+		Logger: o.Logger,
+
+		Middlewares: append([]Middleware{}, o.Middlewares...),
+	}
+
+	if o.RetryPolicy != nil {
+		hClient.RetryPolicy = *o.RetryPolicy
+	}
+
+	if o.RateLimit > 0 {
+		hClient.RateLimiter = NewRateLimiter(o.RateLimit, o.RateLimitBurst)
+	}
+
+	if o.MaxInFlight > 0 {
+		hClient.ConcurrencyLimiter = NewConcurrencyLimiter(o.MaxInFlight)
 	}
 
 	return hClient
@@ -61,68 +100,113 @@ func NewHttpClient(username, password, apiUrl, templatePath string, useHttps boo
 
 // Public methods
 
-func (slc *HttpClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
-	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
+// BaseUrl returns the scheme, host and path of the API endpoint, e.g. https://api.softlayer.com/rest/v3
+func (slc *HttpClient) BaseUrl() string {
+	return fmt.Sprintf("%s://%s", slc.scheme(), slc.apiUrl)
+}
 
-	url += "?objectMask="
-	for i := 0; i < len(masks); i++ {
-		url += masks[i]
-		if i != len(masks)-1 {
-			url += ";"
-		}
-	}
+// Use appends middlewares to the chain every call flows through, e.g.
+//
+//	httpClient.Use(client.HeaderMiddleware(http.Header{"X-Trace-Id": []string{traceId}}), client.MetricsMiddleware(recorder))
+//
+// They run outside of the built-in credentials refresh, retry and logging middlewares.
+func (slc *HttpClient) Use(middlewares ...Middleware) {
+	slc.Middlewares = append(slc.Middlewares, middlewares...)
+}
 
-	return slc.makeHttpRequest(url, requestType, requestBody)
+func (slc *HttpClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.DoRawHttpRequestWithObjectMaskWithContext(context.Background(), path, masks, requestType, requestBody)
 }
 
 func (slc *HttpClient) DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
-	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
-	url += "?objectFilter=" + filters
-
-	return slc.makeHttpRequest(url, requestType, requestBody)
+	return slc.DoRawHttpRequestWithObjectFilterWithContext(context.Background(), path, filters, requestType, requestBody)
 }
 
 func (slc *HttpClient) DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
-	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
+	return slc.DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(context.Background(), path, masks, filters, requestType, requestBody)
+}
 
-	url += "?objectFilter=" + filters
+func (slc *HttpClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.DoRawHttpRequestWithContext(context.Background(), path, requestType, requestBody)
+}
 
-	url += "&objectMask=filteredMask["
-	for i := 0; i < len(masks); i++ {
-		url += masks[i]
-		if i != len(masks)-1 {
-			url += ";"
-		}
+func (slc *HttpClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	query := url.Values{}
+	query.Set("objectMask", strings.Join(masks, ";"))
+
+	return slc.makeHttpRequestToPath(ctx, path, query, requestType, requestBody)
+}
+
+func (slc *HttpClient) DoRawHttpRequestWithObjectFilterWithContext(ctx context.Context, path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	query := url.Values{}
+	query.Set("objectFilter", filters)
+
+	return slc.makeHttpRequestToPath(ctx, path, query, requestType, requestBody)
+}
+
+func (slc *HttpClient) DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx context.Context, path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	query := url.Values{}
+	query.Set("objectFilter", filters)
+	query.Set("objectMask", "filteredMask["+strings.Join(masks, ";")+"]")
+
+	return slc.makeHttpRequestToPath(ctx, path, query, requestType, requestBody)
+}
+
+func (slc *HttpClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.makeHttpRequestToPath(ctx, path, url.Values{}, requestType, requestBody)
+}
+
+func (slc *HttpClient) DoRequest(request *softlayer.Request) ([]byte, int, error) {
+	return slc.DoRequestWithContext(context.Background(), request)
+}
+
+func (slc *HttpClient) DoRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, error) {
+	requestBody, err := request.Body()
+	if err != nil {
+		return nil, 0, err
 	}
-	url += "]"
 
-	return slc.makeHttpRequest(url, requestType, requestBody)
+	return slc.makeHttpRequestToPath(ctx, request.Path(), request.Query(), request.Verb(), requestBody)
 }
 
-func (slc *HttpClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
-	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
-	return slc.makeHttpRequest(url, requestType, requestBody)
+func (slc *HttpClient) DoPagedRequest(request *softlayer.Request) ([]byte, int, int, error) {
+	return slc.DoPagedRequestWithContext(context.Background(), request)
 }
 
-func (slc *HttpClient) GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error) {
-	cwd, err := os.Getwd()
+func (slc *HttpClient) DoPagedRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, int, error) {
+	requestBody, err := request.Body()
 	if err != nil {
-		return nil, err
+		return nil, 0, -1, err
+	}
+
+	responseBody, statusCode, header, err := slc.makeHttpRequestToPathWithHeader(ctx, request.Path(), request.Query(), request.Verb(), requestBody)
+	if err != nil {
+		return responseBody, statusCode, -1, err
 	}
 
-	bodyTemplate := template.Must(template.ParseFiles(filepath.Join(cwd, slc.templatePath)))
-	body := new(bytes.Buffer)
-	bodyTemplate.Execute(body, templateData)
+	return responseBody, statusCode, totalItems(header), nil
+}
 
-	return body, nil
+// GenerateRequestBody renders the template of the client with the data: one of the templates of the templates directory,
+// embedded in the package (e.g. "parameters.json"), otherwise a template file (relative to the working directory or
+// absolute). The data is marshaled to JSON when the template path is empty or the templates directory.
+func (slc *HttpClient) GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error) {
+	return generateRequestBody(slc.templatePath, templateData)
 }
 
 func (slc *HttpClient) HasErrors(body map[string]interface{}) error {
-	if errString, ok := body["error"]; !ok {
+	errString, ok := body["error"]
+	if !ok {
 		return nil
-	} else {
-		return errors.New(errString.(string))
 	}
+
+	slError := &common.SoftLayerError{Message: fmt.Sprintf("%v", errString)}
+	if code, ok := body["code"].(string); ok {
+		slError.Code = code
+	}
+	slError.StatusCode = common.ExceptionStatusCode(slError.Code)
+
+	return slError
 }
 
 func (slc *HttpClient) CheckForHttpResponseErrors(data []byte) error {
@@ -149,66 +233,213 @@ func (slc *HttpClient) scheme() string {
 	return "https"
 }
 
-func (slc *HttpClient) makeHttpRequest(url string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
-	req, err := http.NewRequest(requestType, url, requestBody)
+func (slc *HttpClient) buildUrl(path string, query url.Values) (string, error) {
+	requestUrl, err := url.Parse(fmt.Sprintf("%s://%s/%s", slc.scheme(), slc.apiUrl, path))
 	if err != nil {
-		return nil, 0, err
+		return "", err
+	}
+
+	requestUrl.RawQuery = query.Encode()
+
+	return requestUrl.String(), nil
+}
+
+func (slc *HttpClient) makeHttpRequestToPath(ctx context.Context, path string, query url.Values, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	responseBody, statusCode, _, err := slc.makeHttpRequestToPathWithHeader(ctx, path, query, requestType, requestBody)
+	return responseBody, statusCode, err
+}
+
+func (slc *HttpClient) makeHttpRequestToPathWithHeader(ctx context.Context, path string, query url.Values, requestType string, requestBody *bytes.Buffer) ([]byte, int, http.Header, error) {
+	requestUrl, err := slc.buildUrl(path, query)
+	if err != nil {
+		return nil, 0, nil, err
 	}
 
-	bs, err := httputil.DumpRequest(req, true)
+	return slc.makeHttpRequest(ctx, requestUrl, requestType, requestBody)
+}
+
+func (slc *HttpClient) makeHttpRequest(ctx context.Context, url string, requestType string, requestBody *bytes.Buffer) ([]byte, int, http.Header, error) {
+	var body []byte
+	if requestBody != nil {
+		body = requestBody.Bytes()
+	}
+
+	return slc.makeHttpCall(ctx, &Call{
+		Verb: requestType,
+		Path: url,
+
+		HttpMethod: requestType,
+		Url:        url,
+		Body:       body,
+	})
+}
+
+func (slc *HttpClient) makeHttpCall(ctx context.Context, call *Call) ([]byte, int, http.Header, error) {
+	call.Service, call.Method = softLayerServiceAndMethod(call.Verb, call.Path)
+	call.RequestId = newRequestId()
+	if call.Header == nil {
+		call.Header = http.Header{}
+	}
+
+	response, err := slc.handler()(ctx, call)
+	if response == nil {
+		return nil, 0, nil, err
+	}
+
+	return response.Body, response.StatusCode, response.Header, err
+}
+
+// handler chains the client middlewares, the credentials refresh, the retry policy and the logs around the transport
+func (slc *HttpClient) handler() Handler {
+	middlewares := append([]Middleware{}, slc.Middlewares...)
+	middlewares = append(middlewares,
+		slc.reauthenticateMiddleware,
+		RetryMiddleware(slc.RetryPolicy, slc.logger()),
+		LoggingMiddleware(slc.logger()),
+	)
+
+	return Chain(middlewares...)(slc.send)
+}
+
+// reauthenticateMiddleware replays a call once with fresh credentials after a 401
+func (slc *HttpClient) reauthenticateMiddleware(next Handler) Handler {
+	return func(ctx context.Context, call *Call) (*Response, error) {
+		response, err := next(ctx, call)
+		if err != nil || response == nil || response.StatusCode != http.StatusUnauthorized {
+			return response, err
+		}
+
+		authenticator, ok := slc.Authenticator.(RefreshableAuthenticator)
+		if !ok {
+			return response, err
+		}
+
+		authenticator.Invalidate()
+		slc.log(LOG_LEVEL_WARN, "Refreshing credentials after an unauthorized response", call.logFields(nil))
+
+		return next(ctx, call)
+	}
+}
+
+// send is the end of the middleware chain: it authenticates, rate limits and sends a single HTTP request
+func (slc *HttpClient) send(ctx context.Context, call *Call) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, call.HttpMethod, call.Url, bytes.NewReader(call.Body))
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	if !slc.nonVerbose {
-		fmt.Fprintf(os.Stderr, "\n---\n[softlayer-go] Request:\n%s\n", hideCredentials(string(bs)))
+	for key, values := range call.Header {
+		req.Header[key] = append([]string{}, values...)
+	}
+
+	if call.ContentType != "" {
+		req.Header.Set("Content-Type", call.ContentType)
+	}
+
+	if slc.Authenticator != nil {
+		if err := slc.Authenticator.Authenticate(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if slc.ConcurrencyLimiter != nil {
+		if err := slc.ConcurrencyLimiter.Acquire(ctx); err != nil {
+			return &Response{StatusCode: 520}, err
+		}
+		defer slc.ConcurrencyLimiter.Release()
+	}
+
+	if slc.RateLimiter != nil {
+		if err := slc.RateLimiter.Wait(ctx); err != nil {
+			return &Response{StatusCode: 520}, err
+		}
+	}
+
+	if slc.logger().IsEnabled(LOG_LEVEL_DEBUG) {
+		bs, err := httputil.DumpRequest(req, true)
+		if err != nil {
+			return nil, err
+		}
+
+		slc.log(LOG_LEVEL_DEBUG, "Request", call.logFields(LogFields{"dump": Redact(string(bs))}))
 	}
 
 	resp, err := slc.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 520, err
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+
+		return &Response{StatusCode: 520}, err
 	}
 
 	defer resp.Body.Close()
 
-	bs, err = httputil.DumpResponse(resp, true)
+	if delay, ok := retryAfter(resp.Header); ok && slc.RateLimiter != nil {
+		slc.RateLimiter.PauseUntil(time.Now().Add(delay))
+	}
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp.StatusCode, err
+		return &Response{StatusCode: resp.StatusCode, Header: resp.Header}, err
 	}
 
-	if !slc.nonVerbose {
-		fmt.Fprintf(os.Stderr, "[softlayer-go] Response:\n%s\n", hideCredentials(string(bs)))
+	if slc.logger().IsEnabled(LOG_LEVEL_DEBUG) {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+		bs, err := httputil.DumpResponse(resp, true)
+		if err == nil {
+			slc.log(LOG_LEVEL_DEBUG, "Response", call.logFields(LogFields{"status": resp.StatusCode, "dump": Redact(string(bs))}))
+		}
 	}
 
-	responseBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, resp.StatusCode, err
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: responseBody}, nil
+}
+
+func (slc *HttpClient) logger() Logger {
+	if slc.Logger == nil {
+		return nopLogger{}
 	}
 
-	return responseBody, resp.StatusCode, nil
+	return slc.Logger
+}
+
+func (slc *HttpClient) log(level LogLevel, message string, fields LogFields) {
+	logger := slc.logger()
+	if logger.IsEnabled(level) {
+		logger.Log(level, message, fields)
+	}
 }
 
 // Private functions
 
-func hideCredentials(s string) string {
-	hiddenStr := "\"password\":\"******\""
-	r := regexp.MustCompile(`"password":"[^"]*"`)
+func totalItems(header http.Header) int {
+	totalItems, err := strconv.Atoi(header.Get(SOFTLAYER_TOTAL_ITEMS_HEADER))
+	if err != nil {
+		return -1
+	}
+
+	return totalItems
+}
+
+// softLayerServiceAndMethod are the service and method of a REST call, the method being implied by the verb when the
+// path has none (e.g. deleteObject for a DELETE of SoftLayer_Virtual_Guest/1234.json) and object ids skipped
+func softLayerServiceAndMethod(requestType string, requestUrl string) (string, string) {
+	requestPath := strings.SplitN(requestUrl, "?", 2)[0]
+
+	for _, segment := range strings.Split(requestPath, "/") {
+		if strings.HasPrefix(segment, "SoftLayer_") {
+			return strings.TrimSuffix(segment, ".json"), softLayerMethodName(requestType, requestPath)
+		}
+	}
 
-	return r.ReplaceAllString(s, hiddenStr)
+	return "", ""
 }
 
-func checkNonVerbose() bool {
-	slGoNonVerbose := os.Getenv(NON_VERBOSE)
-	switch slGoNonVerbose {
-	case "yes":
-		return true
-	case "YES":
-		return true
-	case "true":
-		return true
-	case "TRUE":
-		return true
+func newRequestId() string {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return ""
 	}
 
-	return false
+	return hex.EncodeToString(id)
 }
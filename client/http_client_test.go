@@ -1,6 +1,24 @@
 package client_test
 
-//TODO: need a fake Golang HTTPClient to implement this test.
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	common "github.com/maximilien/softlayer-go/common"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
 
 //Public methods
 // DoRawHttpRequestWithObjectMask
@@ -10,3 +28,308 @@ package client_test
 // GenerateRequestBody
 // HasErrors
 // CheckForHttpResponseErrors
+
+var _ = Describe("HttpClient", func() {
+	var (
+		server     *httptest.Server
+		httpClient *slclient.HttpClient
+
+		requestCount  int
+		requestBodies []string
+		requestUrls   []*url.URL
+		statusCodes   []int
+		totalItems    string
+	)
+
+	BeforeEach(func() {
+		requestCount = 0
+		requestBodies = []string{}
+		requestUrls = []*url.URL{}
+		statusCodes = []int{}
+		totalItems = ""
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requestBodies = append(requestBodies, string(body))
+			requestUrls = append(requestUrls, r.URL)
+
+			statusCode := http.StatusOK
+			if requestCount < len(statusCodes) {
+				statusCode = statusCodes[requestCount]
+			}
+			requestCount++
+
+			if totalItems != "" {
+				w.Header().Set(slclient.SOFTLAYER_TOTAL_ITEMS_HEADER, totalItems)
+			}
+			w.WriteHeader(statusCode)
+			w.Write([]byte(`{}`))
+		}))
+
+		httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), "templates", false)
+		httpClient.RetryPolicy.BaseDelay = 1 * time.Millisecond
+		httpClient.RetryPolicy.MaxDelay = 5 * time.Millisecond
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("#DoRawHttpRequest retries", func() {
+		It("retries GET requests on transient server errors", func() {
+			statusCodes = []int{503, 502}
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(requestCount).To(Equal(3))
+		})
+
+		It("replays the request body on each attempt", func() {
+			statusCodes = []int{500}
+			httpClient.RetryPolicy.RetryableVerbs = []string{"GET", "POST"}
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/setTags.json", "POST", bytes.NewBufferString(`{"parameters":["tag"]}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(requestBodies).To(Equal([]string{`{"parameters":["tag"]}`, `{"parameters":["tag"]}`}))
+		})
+
+		It("gives up after MaxAttempts and returns the last status code", func() {
+			statusCodes = []int{500, 500, 500, 500}
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(500))
+			Expect(requestCount).To(Equal(httpClient.RetryPolicy.MaxAttempts))
+		})
+
+		It("does not retry status codes which are not retryable", func() {
+			statusCodes = []int{404}
+
+			_, errorCode, _ := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", new(bytes.Buffer))
+			Expect(errorCode).To(Equal(404))
+			Expect(requestCount).To(Equal(1))
+		})
+
+		It("never replays placeOrder", func() {
+			statusCodes = []int{503}
+			httpClient.RetryPolicy.RetryableVerbs = []string{"POST"}
+			httpClient.RetryPolicy.NonIdempotentMethods = []string{}
+
+			_, errorCode, _ := httpClient.DoRawHttpRequest("SoftLayer_Product_Order/placeOrder.json", "POST", bytes.NewBufferString(`{}`))
+			Expect(errorCode).To(Equal(503))
+			Expect(requestCount).To(Equal(1))
+		})
+
+		It("never replays the createObject of a POST to the service", func() {
+			statusCodes = []int{503}
+			httpClient.RetryPolicy.RetryableVerbs = []string{"GET", "POST"}
+
+			_, errorCode, _ := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest.json", "POST", bytes.NewBufferString(`{"parameters":[{}]}`))
+			Expect(errorCode).To(Equal(503))
+			Expect(requestCount).To(Equal(1))
+		})
+
+		It("stops retrying when the context is canceled", func() {
+			statusCodes = []int{503, 503, 503}
+			httpClient.RetryPolicy.BaseDelay = 1 * time.Second
+			httpClient.RetryPolicy.MaxDelay = 1 * time.Second
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, _, err := httpClient.DoRawHttpRequestWithContext(ctx, "SoftLayer_Account.json", "GET", new(bytes.Buffer))
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(requestCount).To(Equal(1))
+		})
+	})
+
+	Context("#DoRequest", func() {
+		It("sends the request to the path built from the service, id and method", func() {
+			request := softlayer.NewRequest("SoftLayer_Virtual_Guest", "getObject").WithId(1234)
+
+			_, errorCode, err := httpClient.DoRequest(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(requestUrls[0].Path).To(Equal("/SoftLayer_Virtual_Guest/1234/getObject.json"))
+		})
+
+		It("URL-encodes the object mask, object filter and result limit", func() {
+			request := softlayer.NewRequest("SoftLayer_Account", "getVirtualGuests").
+				WithMask("id", "hostname").
+				WithFilter(`{"virtualGuests":{"hostname":{"operation":"foo bar"}}}`).
+				WithResultLimit(10).
+				WithOffset(20)
+
+			_, _, err := httpClient.DoRequest(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			query := requestUrls[0].Query()
+			Expect(query.Get("objectMask")).To(Equal("mask[id;hostname]"))
+			Expect(query.Get("objectFilter")).To(Equal(`{"virtualGuests":{"hostname":{"operation":"foo bar"}}}`))
+			Expect(query.Get("resultLimit")).To(Equal("20,10"))
+		})
+
+		It("POSTs the parameters", func() {
+			request := softlayer.NewRequest("SoftLayer_Virtual_Guest", "setTags").WithId(1234).WithParameters("tag1,tag2")
+
+			_, _, err := httpClient.DoRequest(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requestBodies[0]).To(Equal(`{"parameters":["tag1,tag2"]}`))
+		})
+	})
+
+	Context("#DoPagedRequest", func() {
+		It("returns the total number of items from the SoftLayer-Total-Items header", func() {
+			totalItems = "535"
+			request := softlayer.NewRequest("SoftLayer_Account", "getVirtualGuests").WithResultLimit(100).WithOffset(200)
+
+			_, errorCode, total, err := httpClient.DoPagedRequest(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(total).To(Equal(535))
+			Expect(requestUrls[0].Query().Get("resultLimit")).To(Equal("200,100"))
+		})
+
+		It("returns -1 when the header is missing", func() {
+			_, _, total, err := httpClient.DoPagedRequest(softlayer.NewRequest("SoftLayer_Account", "getVirtualGuests"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(total).To(Equal(-1))
+		})
+	})
+
+	Context("#DoRawHttpRequestWithObjectFilterAndObjectMask", func() {
+		It("URL-encodes the object mask and object filter", func() {
+			_, _, err := httpClient.DoRawHttpRequestWithObjectFilterAndObjectMask("SoftLayer_Account/getVirtualGuests.json", []string{"id", "hostname"}, `{"virtualGuests":{"primaryBackendIpAddress":{"operation":"10.0.0.1"}}}`, "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			query := requestUrls[0].Query()
+			Expect(query.Get("objectMask")).To(Equal("filteredMask[id;hostname]"))
+			Expect(query.Get("objectFilter")).To(Equal(`{"virtualGuests":{"primaryBackendIpAddress":{"operation":"10.0.0.1"}}}`))
+		})
+	})
+
+	Context("#CheckForHttpResponseErrors", func() {
+		It("returns a SoftLayerError with the status code of the SoftLayer exception", func() {
+			err := httpClient.CheckForHttpResponseErrors([]byte(`{"error":"Unable to find object with id of '1234'.","code":"SoftLayer_Exception_ObjectNotFound"}`))
+			Expect(err).To(HaveOccurred())
+			Expect(common.IsNotFound(err)).To(BeTrue())
+
+			slError, ok := common.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slError.StatusCode).To(Equal(404))
+			Expect(slError.Message).To(Equal("Unable to find object with id of '1234'."))
+		})
+
+		It("does not fail without error in the response", func() {
+			Expect(httpClient.CheckForHttpResponseErrors([]byte(`{"id":1234}`))).To(Succeed())
+		})
+	})
+
+	Context("#GenerateRequestBody", func() {
+		var templateData map[string]interface{}
+
+		BeforeEach(func() {
+			templateData = map[string]interface{}{"hostname": "fake-hostname", "domain": "fake-domain.com"}
+		})
+
+		It("marshals the template data when the client has the templates directory", func() {
+			body, err := httpClient.GenerateRequestBody(templateData)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.String()).To(MatchJSON(`{"hostname": "fake-hostname", "domain": "fake-domain.com"}`))
+		})
+
+		It("renders an embedded template", func() {
+			httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), "parameters.json", false)
+
+			body, err := httpClient.GenerateRequestBody([]interface{}{templateData})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.String()).To(MatchJSON(`{"parameters": [{"hostname": "fake-hostname", "domain": "fake-domain.com"}]}`))
+		})
+
+		Context("with a template file", func() {
+			var templateDir string
+
+			BeforeEach(func() {
+				var err error
+				templateDir, err = ioutil.TempDir("", "softlayer-go-templates")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(templateDir)
+			})
+
+			It("renders the template", func() {
+				templatePath := filepath.Join(templateDir, "virtual_guest.json")
+				Expect(ioutil.WriteFile(templatePath, []byte(`{"parameters": [{"hostname": "{{.hostname}}", "domain": {{json .domain}}}]}`), 0644)).To(Succeed())
+
+				httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), templatePath, false)
+
+				body, err := httpClient.GenerateRequestBody(templateData)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(body.String()).To(MatchJSON(`{"parameters": [{"hostname": "fake-hostname", "domain": "fake-domain.com"}]}`))
+			})
+
+			It("fails for an invalid template", func() {
+				templatePath := filepath.Join(templateDir, "invalid.json")
+				Expect(ioutil.WriteFile(templatePath, []byte(`{"hostname": "{{.hostname"}`), 0644)).To(Succeed())
+
+				httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), templatePath, false)
+
+				_, err := httpClient.GenerateRequestBody(templateData)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("fails when the template cannot be executed", func() {
+				templatePath := filepath.Join(templateDir, "invalid.json")
+				Expect(ioutil.WriteFile(templatePath, []byte(`{"hostname": "{{.Hostname.Missing}}"}`), 0644)).To(Succeed())
+
+				httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), templatePath, false)
+
+				_, err := httpClient.GenerateRequestBody(struct{ Hostname string }{Hostname: "fake-hostname"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		It("fails for a missing template", func() {
+			httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), "missing.json", false)
+
+			_, err := httpClient.GenerateRequestBody(templateData)
+			Expect(err).To(MatchError("softlayer-go cannot find the request body template 'missing.json'"))
+		})
+
+		It("fails when the template data cannot be marshaled", func() {
+			_, err := httpClient.GenerateRequestBody(map[string]interface{}{"channel": make(chan int)})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when the working directory was removed", func() {
+			var wd string
+
+			BeforeEach(func() {
+				var err error
+				wd, err = os.Getwd()
+				Expect(err).ToNot(HaveOccurred())
+
+				removedDir, err := ioutil.TempDir("", "softlayer-go-wd")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Chdir(removedDir)).To(Succeed())
+				Expect(os.Remove(removedDir)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(wd)).To(Succeed())
+			})
+
+			It("creates the client and renders the embedded templates", func() {
+				httpClient = slclient.NewHttpClient("fake-username", "fake-api-key", strings.TrimPrefix(server.URL, "http://"), "parameters.json", false)
+
+				body, err := httpClient.GenerateRequestBody([]interface{}{templateData})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(body.String()).To(MatchJSON(`{"parameters": [{"hostname": "fake-hostname", "domain": "fake-domain.com"}]}`))
+			})
+		})
+	})
+})
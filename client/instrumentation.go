@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	PROMETHEUS_NAMESPACE = "softlayer_api"
+
+	PROMETHEUS_CONTENT_TYPE = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// PROMETHEUS_DEFAULT_BUCKETS are the latency buckets in seconds, the same as the Prometheus client defaults
+var PROMETHEUS_DEFAULT_BUCKETS = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// PrometheusTextMetrics is a MetricsRecorder exposing, in the Prometheus text format, the number of calls per service,
+// method and status code (softlayer_api_requests_total) and their latency per service and method
+// (softlayer_api_request_duration_seconds). The services are named as by their GetName(), the methods by the REST
+// path and verb (e.g. deleteObject), never by object ids. Mount it as the handler of a /metrics endpoint.
+// It writes the text format itself, without depending on the Prometheus client: to register the same metrics in an
+// existing prometheus.Registry, use the prometheus.Collector of the client/prometheus package instead.
+type PrometheusTextMetrics struct {
+	Namespace string
+	Buckets   []float64
+
+	requests  map[prometheusRequestKey]int
+	latencies map[prometheusLatencyKey]*prometheusHistogram
+
+	mutex sync.Mutex
+}
+
+func NewPrometheusTextMetrics() *PrometheusTextMetrics {
+	return &PrometheusTextMetrics{
+		Namespace: PROMETHEUS_NAMESPACE,
+		Buckets:   PROMETHEUS_DEFAULT_BUCKETS,
+
+		requests:  map[prometheusRequestKey]int{},
+		latencies: map[prometheusLatencyKey]*prometheusHistogram{},
+	}
+}
+
+func (m *PrometheusTextMetrics) RecordCall(call *Call, response *Response, latency time.Duration, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	code := "error"
+	if response != nil && response.StatusCode != 0 {
+		code = strconv.Itoa(response.StatusCode)
+	}
+
+	m.requests[prometheusRequestKey{service: call.Service, method: call.Method, code: code}]++
+
+	latencyKey := prometheusLatencyKey{service: call.Service, method: call.Method}
+	histogram, ok := m.latencies[latencyKey]
+	if !ok {
+		histogram = &prometheusHistogram{buckets: make([]int, len(m.Buckets))}
+		m.latencies[latencyKey] = histogram
+	}
+
+	seconds := latency.Seconds()
+	for i, bound := range m.Buckets {
+		if seconds <= bound {
+			histogram.buckets[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += seconds
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format
+func (m *PrometheusTextMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	out := &strings.Builder{}
+
+	requestsName := m.Namespace + "_requests_total"
+	fmt.Fprintf(out, "# HELP %s Number of SoftLayer API calls by service, method and status code.\n", requestsName)
+	fmt.Fprintf(out, "# TYPE %s counter\n", requestsName)
+
+	requestKeys := make([]prometheusRequestKey, 0, len(m.requests))
+	for key := range m.requests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		return requestKeys[i].String() < requestKeys[j].String()
+	})
+
+	for _, key := range requestKeys {
+		fmt.Fprintf(out, "%s{%s} %d\n", requestsName, key, m.requests[key])
+	}
+
+	latencyName := m.Namespace + "_request_duration_seconds"
+	fmt.Fprintf(out, "# HELP %s Latency of the SoftLayer API calls by service and method.\n", latencyName)
+	fmt.Fprintf(out, "# TYPE %s histogram\n", latencyName)
+
+	latencyKeys := make([]prometheusLatencyKey, 0, len(m.latencies))
+	for key := range m.latencies {
+		latencyKeys = append(latencyKeys, key)
+	}
+	sort.Slice(latencyKeys, func(i, j int) bool {
+		return latencyKeys[i].String() < latencyKeys[j].String()
+	})
+
+	for _, key := range latencyKeys {
+		histogram := m.latencies[key]
+		for i, bound := range m.Buckets {
+			fmt.Fprintf(out, "%s_bucket{%s,le=\"%s\"} %d\n", latencyName, key, strconv.FormatFloat(bound, 'g', -1, 64), histogram.buckets[i])
+		}
+		fmt.Fprintf(out, "%s_bucket{%s,le=\"+Inf\"} %d\n", latencyName, key, histogram.count)
+		fmt.Fprintf(out, "%s_sum{%s} %s\n", latencyName, key, strconv.FormatFloat(histogram.sum, 'g', -1, 64))
+		fmt.Fprintf(out, "%s_count{%s} %d\n", latencyName, key, histogram.count)
+	}
+
+	n, err := io.WriteString(w, out.String())
+	return int64(n), err
+}
+
+func (m *PrometheusTextMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", PROMETHEUS_CONTENT_TYPE)
+	m.WriteTo(w)
+}
+
+// Tracer starts the spans of the calls, it is implemented on top of an OpenTelemetry trace.Tracer with e.g.
+//
+//	func (t otelTracer) Start(ctx context.Context, name string) (context.Context, client.Span) {
+//		ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttributes(attributes map[string]interface{})
+	RecordError(err error)
+	End()
+}
+
+// TracingMiddleware wraps each call in a span named <service>/<method> with the OpenTelemetry RPC and HTTP attributes.
+// The span context is passed down, so the spans of an instrumented transport are its children.
+func TracingMiddleware(tracer Tracer) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			ctx, span := tracer.Start(ctx, call.Service+"/"+call.Method)
+			defer span.End()
+
+			span.SetAttributes(map[string]interface{}{
+				"rpc.system":           "softlayer",
+				"rpc.service":          call.Service,
+				"rpc.method":           call.Method,
+				"http.method":          call.HttpMethod,
+				"softlayer.request_id": call.RequestId,
+			})
+
+			response, err := next(ctx, call)
+
+			if response != nil {
+				span.SetAttributes(map[string]interface{}{
+					"http.status_code":  response.StatusCode,
+					"softlayer.attempt": call.Attempt,
+				})
+			}
+
+			switch {
+			case err != nil:
+				span.RecordError(err)
+			case response != nil && response.StatusCode >= 400:
+				span.RecordError(fmt.Errorf("softlayer-go: %s/%s returned HTTP %d", call.Service, call.Method, response.StatusCode))
+			}
+
+			return response, err
+		}
+	}
+}
+
+// WithMetrics records the calls, once per call whatever the number of retries
+func WithMetrics(recorder MetricsRecorder) Option {
+	return WithMiddlewares(MetricsMiddleware(recorder))
+}
+
+// WithTracer traces the calls, see TracingMiddleware
+func WithTracer(tracer Tracer) Option {
+	return WithMiddlewares(TracingMiddleware(tracer))
+}
+
+// Private types
+
+type prometheusRequestKey struct {
+	service string
+	method  string
+	code    string
+}
+
+func (k prometheusRequestKey) String() string {
+	return fmt.Sprintf(`service="%s",method="%s",code="%s"`, escapeLabelValue(k.service), escapeLabelValue(k.method), k.code)
+}
+
+type prometheusLatencyKey struct {
+	service string
+	method  string
+}
+
+func (k prometheusLatencyKey) String() string {
+	return fmt.Sprintf(`service="%s",method="%s"`, escapeLabelValue(k.service), escapeLabelValue(k.method))
+}
+
+type prometheusHistogram struct {
+	buckets []int
+	count   int
+	sum     float64
+}
+
+// Private functions
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
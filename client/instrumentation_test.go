@@ -0,0 +1,183 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("Instrumentation", func() {
+	var (
+		server     *httptest.Server
+		statusCode int
+	)
+
+	BeforeEach(func() {
+		statusCode = http.StatusOK
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCode)
+			w.Write([]byte(`{}`))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newSoftLayerClient := func(options ...slclient.Option) *slclient.SoftLayerClient {
+		options = append([]slclient.Option{
+			slclient.WithBaseUrl(server.URL),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithRetryPolicy(slclient.NoRetryPolicy()),
+		}, options...)
+
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key", options...)
+	}
+
+	Context("PrometheusTextMetrics", func() {
+		It("counts the calls by service name, method and status code", func() {
+			metrics := slclient.NewPrometheusTextMetrics()
+			client := newSoftLayerClient(slclient.WithMetrics(metrics))
+
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = virtualGuestService.GetObject(1234)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = virtualGuestService.GetObject(1234)
+			Expect(err).ToNot(HaveOccurred())
+
+			statusCode = http.StatusNotFound
+			_, err = virtualGuestService.GetObject(1234)
+			Expect(err).To(HaveOccurred())
+
+			buffer := &bytes.Buffer{}
+			_, err = metrics.WriteTo(buffer)
+			Expect(err).ToNot(HaveOccurred())
+
+			name := virtualGuestService.GetName()
+			Expect(buffer.String()).To(ContainSubstring("# TYPE softlayer_api_requests_total counter\n"))
+			Expect(buffer.String()).To(ContainSubstring(`softlayer_api_requests_total{service="` + name + `",method="getObject",code="200"} 2` + "\n"))
+			Expect(buffer.String()).To(ContainSubstring(`softlayer_api_requests_total{service="` + name + `",method="getObject",code="404"} 1` + "\n"))
+			Expect(buffer.String()).To(ContainSubstring("# TYPE softlayer_api_request_duration_seconds histogram\n"))
+			Expect(buffer.String()).To(ContainSubstring(`softlayer_api_request_duration_seconds_bucket{service="` + name + `",method="getObject",le="+Inf"} 3` + "\n"))
+			Expect(buffer.String()).To(ContainSubstring(`softlayer_api_request_duration_seconds_count{service="` + name + `",method="getObject"} 3` + "\n"))
+		})
+
+		It("labels the calls on object ids by method rather than id", func() {
+			metrics := slclient.NewPrometheusTextMetrics()
+			client := newSoftLayerClient(slclient.WithMetrics(metrics))
+
+			_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/1234.json", "DELETE", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = client.GetHttpClient().DoRawHttpRequest("SoftLayer_Virtual_Guest/1234.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			buffer := &bytes.Buffer{}
+			_, err = metrics.WriteTo(buffer)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buffer.String()).To(ContainSubstring(`softlayer_api_requests_total{service="SoftLayer_Virtual_Guest",method="deleteObject",code="200"} 1` + "\n"))
+			Expect(buffer.String()).To(ContainSubstring(`softlayer_api_requests_total{service="SoftLayer_Virtual_Guest",method="getObject",code="200"} 1` + "\n"))
+			Expect(buffer.String()).ToNot(ContainSubstring(`method="1234"`))
+		})
+
+		It("fills the latency buckets", func() {
+			metrics := slclient.NewPrometheusTextMetrics()
+			metrics.Buckets = []float64{0.1, 1}
+
+			call := &slclient.Call{Service: "SoftLayer_Account", Method: "getVirtualGuests"}
+			metrics.RecordCall(call, &slclient.Response{StatusCode: 200}, 50*time.Millisecond, nil)
+			metrics.RecordCall(call, &slclient.Response{StatusCode: 200}, 500*time.Millisecond, nil)
+			metrics.RecordCall(call, &slclient.Response{StatusCode: 520}, 2*time.Second, nil)
+
+			recorder := httptest.NewRecorder()
+			metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+			Expect(recorder.Header().Get("Content-Type")).To(Equal(slclient.PROMETHEUS_CONTENT_TYPE))
+			Expect(recorder.Body.String()).To(ContainSubstring(`softlayer_api_request_duration_seconds_bucket{service="SoftLayer_Account",method="getVirtualGuests",le="0.1"} 1` + "\n"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`softlayer_api_request_duration_seconds_bucket{service="SoftLayer_Account",method="getVirtualGuests",le="1"} 2` + "\n"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`softlayer_api_request_duration_seconds_bucket{service="SoftLayer_Account",method="getVirtualGuests",le="+Inf"} 3` + "\n"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`softlayer_api_request_duration_seconds_sum{service="SoftLayer_Account",method="getVirtualGuests"} 2.55` + "\n"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`softlayer_api_requests_total{service="SoftLayer_Account",method="getVirtualGuests",code="520"} 1` + "\n"))
+		})
+	})
+
+	Context("TracingMiddleware", func() {
+		It("starts a span per call with its service, method and status code", func() {
+			tracer := &fakeTracer{}
+			client := newSoftLayerClient(slclient.WithTracer(tracer))
+
+			sshKeyService, err := client.GetSoftLayer_Security_Ssh_Key_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = sshKeyService.GetObject(1234)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(tracer.spans).To(HaveLen(1))
+			span := tracer.spans[0]
+			Expect(span.name).To(Equal(sshKeyService.GetName() + "/getObject"))
+			Expect(span.ended).To(BeTrue())
+			Expect(span.errors).To(BeEmpty())
+			Expect(span.attributes["rpc.system"]).To(Equal("softlayer"))
+			Expect(span.attributes["rpc.service"]).To(Equal(sshKeyService.GetName()))
+			Expect(span.attributes["rpc.method"]).To(Equal("getObject"))
+			Expect(span.attributes["http.method"]).To(Equal("GET"))
+			Expect(span.attributes["http.status_code"]).To(Equal(200))
+			Expect(span.attributes["softlayer.request_id"]).ToNot(BeEmpty())
+		})
+
+		It("records the HTTP errors on the span", func() {
+			statusCode = http.StatusInternalServerError
+			tracer := &fakeTracer{}
+			client := newSoftLayerClient(slclient.WithTracer(tracer))
+
+			_, _, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Account/getVirtualGuests.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(tracer.spans).To(HaveLen(1))
+			Expect(tracer.spans[0].errors).To(HaveLen(1))
+			Expect(tracer.spans[0].attributes["http.status_code"]).To(Equal(500))
+		})
+	})
+})
+
+type fakeTracer struct {
+	spans []*fakeSpan
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string) (context.Context, slclient.Span) {
+	span := &fakeSpan{name: name, attributes: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+
+	return ctx, span
+}
+
+type fakeSpan struct {
+	name       string
+	attributes map[string]interface{}
+	errors     []error
+	ended      bool
+}
+
+func (s *fakeSpan) SetAttributes(attributes map[string]interface{}) {
+	for key, value := range attributes {
+		s.attributes[key] = value
+	}
+}
+
+func (s *fakeSpan) RecordError(err error) {
+	s.errors = append(s.errors, err)
+}
+
+func (s *fakeSpan) End() {
+	s.ended = true
+}
@@ -0,0 +1,201 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type LogLevel int
+
+const (
+	LOG_LEVEL_DEBUG LogLevel = iota
+	LOG_LEVEL_INFO
+	LOG_LEVEL_WARN
+	LOG_LEVEL_ERROR
+	LOG_LEVEL_NONE
+)
+
+const REDACTED = "******"
+
+// JSON fields and XML-RPC struct members whose values are never logged, SoftLayer_Network_Storage_Credential and
+// SoftLayer_Virtual_Guest passwords and the apiKey of the XML-RPC authenticate header included
+var REDACTED_FIELDS = []string{
+	"password",
+	"apiKey",
+	"api_key",
+	"authenticationKey",
+	"accessToken",
+	"access_token",
+	"refreshToken",
+	"refresh_token",
+	"secret",
+	"secretKey",
+	"privateKey",
+}
+
+func (l LogLevel) String() string {
+	switch l {
+	case LOG_LEVEL_DEBUG:
+		return "DEBUG"
+	case LOG_LEVEL_INFO:
+		return "INFO"
+	case LOG_LEVEL_WARN:
+		return "WARN"
+	case LOG_LEVEL_ERROR:
+		return "ERROR"
+	}
+
+	return "NONE"
+}
+
+// LogFields are the structured fields of a log entry, e.g. service, method, status, latency and request_id
+type LogFields map[string]interface{}
+
+type Logger interface {
+	Log(level LogLevel, message string, fields LogFields)
+
+	IsEnabled(level LogLevel) bool
+}
+
+type nopLogger struct{}
+
+func NewNopLogger() Logger {
+	return nopLogger{}
+}
+
+func (l nopLogger) Log(level LogLevel, message string, fields LogFields) {}
+
+func (l nopLogger) IsEnabled(level LogLevel) bool {
+	return false
+}
+
+// StandardLogger writes one line per entry, e.g.
+//
+//	2016-03-11T10:00:00Z [softlayer-go] INFO SoftLayer API call latency=120ms method=getObject service=SoftLayer_Virtual_Guest status=200
+type StandardLogger struct {
+	Level LogLevel
+
+	writer io.Writer
+	mutex  sync.Mutex
+}
+
+func NewStandardLogger(writer io.Writer, level LogLevel) *StandardLogger {
+	return &StandardLogger{
+		Level: level,
+
+		writer: writer,
+	}
+}
+
+func (l *StandardLogger) IsEnabled(level LogLevel) bool {
+	return level >= l.Level && level < LOG_LEVEL_NONE
+}
+
+func (l *StandardLogger) Log(level LogLevel, message string, fields LogFields) {
+	if !l.IsEnabled(level) {
+		return
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	line := fmt.Sprintf("%s [softlayer-go] %s %s", time.Now().UTC().Format(time.RFC3339), level, message)
+	for _, key := range keys {
+		line += fmt.Sprintf(" %s=%s", key, formatLogValue(fields[key]))
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	fmt.Fprintln(l.writer, line)
+}
+
+// DefaultLogger logs everything to stderr, unless the deprecated NON_VERBOSE environment variable is set
+func DefaultLogger() Logger {
+	if checkNonVerbose() {
+		return NewNopLogger()
+	}
+
+	return NewStandardLogger(os.Stderr, LOG_LEVEL_DEBUG)
+}
+
+// Redact masks the credentials found in logged requests and responses: the REDACTED_FIELDS JSON fields and
+// XML-RPC struct members, Authorization headers and credentials in URLs
+func Redact(s string) string {
+	for _, r := range redactors {
+		s = r.pattern.ReplaceAllString(s, r.replacement)
+	}
+
+	return s
+}
+
+// Private types
+
+type redactor struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+var redactors = newRedactors()
+
+// Private functions
+
+func newRedactors() []redactor {
+	fields := make([]string, len(REDACTED_FIELDS))
+	for i, field := range REDACTED_FIELDS {
+		fields[i] = regexp.QuoteMeta(field)
+	}
+
+	return []redactor{
+		{
+			pattern:     regexp.MustCompile(`("(?:` + strings.Join(fields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+			replacement: `$1"` + REDACTED + `"`,
+		},
+		{
+			pattern:     regexp.MustCompile(`(<name>\s*(?:` + strings.Join(fields, "|") + `)\s*</name>\s*<value>\s*(?:<string>)?)[^<]*`),
+			replacement: "${1}" + REDACTED,
+		},
+		{
+			pattern:     regexp.MustCompile(`(?im)^(Authorization:\s*\S+)\s+\S+`),
+			replacement: "$1 " + REDACTED,
+		},
+		{
+			pattern:     regexp.MustCompile(`(://[^/:@\s]*):[^/@\s]*@`),
+			replacement: "$1:" + REDACTED + "@",
+		},
+	}
+}
+
+func formatLogValue(value interface{}) string {
+	s := fmt.Sprintf("%v", value)
+	if strings.ContainsAny(s, " \t\r\n\"=") {
+		return fmt.Sprintf("%q", s)
+	}
+
+	return s
+}
+
+func checkNonVerbose() bool {
+	slGoNonVerbose := os.Getenv(NON_VERBOSE)
+	switch slGoNonVerbose {
+	case "yes":
+		return true
+	case "YES":
+		return true
+	case "true":
+		return true
+	case "TRUE":
+		return true
+	}
+
+	return false
+}
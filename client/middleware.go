@@ -0,0 +1,245 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Call is a single SoftLayer API call flowing through the middleware chain of an HttpClient
+type Call struct {
+	Service   string
+	Method    string
+	RequestId string
+
+	//REST verb and path of the call, they drive the retry policy even when the call is sent otherwise (e.g. POSTed over XML-RPC)
+	Verb string
+	Path string
+
+	//Set by the retry middleware, starting at 1
+	Attempt int
+
+	HttpMethod  string
+	Url         string
+	ContentType string
+
+	//Added to the outgoing HTTP request, e.g. tracing headers
+	Header http.Header
+
+	//Replayed on each attempt
+	Body []byte
+}
+
+type Response struct {
+	//520 when the request could not be sent
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+type Handler func(ctx context.Context, call *Call) (*Response, error)
+
+type Middleware func(next Handler) Handler
+
+// Chain composes the middlewares, the first one being the outermost
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next Handler) Handler {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+
+		return next
+	}
+}
+
+// RetryMiddleware replays the retryable calls failing with a transport error or a retryable status code,
+// waiting for the policy backoff or the Retry-After of the response when longer
+func RetryMiddleware(policy RetryPolicy, logger Logger) Middleware {
+	if logger == nil {
+		logger = NewNopLogger()
+	}
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			maxAttempts := 1
+			if policy.IsRetryableRequest(call.Verb, call.Path) {
+				maxAttempts = policy.MaxAttempts
+			}
+
+			var (
+				response *Response
+				err      error
+			)
+
+			for attempt := 1; ; attempt++ {
+				call.Attempt = attempt
+
+				if attempt > 1 {
+					delay := policy.Backoff(attempt - 1)
+					if retryAfterDelay, ok := retryAfter(response.Header); ok && retryAfterDelay > delay {
+						delay = retryAfterDelay
+					}
+
+					if logger.IsEnabled(LOG_LEVEL_WARN) {
+						logger.Log(LOG_LEVEL_WARN, "Retrying request", call.logFields(LogFields{"max_attempts": maxAttempts, "previous_status": response.StatusCode, "delay": delay}))
+					}
+
+					select {
+					case <-ctx.Done():
+						return &Response{StatusCode: 520}, ctx.Err()
+					case <-time.After(delay):
+					}
+				}
+
+				response, err = next(ctx, call)
+				if response == nil {
+					response = &Response{}
+				}
+
+				if ctx.Err() != nil {
+					return response, err
+				}
+
+				if err != nil && response.StatusCode == 0 {
+					return response, err
+				}
+
+				if err == nil && !policy.IsRetryableStatusCode(response.StatusCode) {
+					return response, nil
+				}
+
+				if attempt >= maxAttempts {
+					return response, err
+				}
+			}
+		}
+	}
+}
+
+// LoggingMiddleware logs one entry per call with its service, method, status, latency and request id
+func LoggingMiddleware(logger Logger) Middleware {
+	if logger == nil {
+		logger = NewNopLogger()
+	}
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			start := time.Now()
+			response, err := next(ctx, call)
+			latency := time.Since(start)
+
+			fields := LogFields{"latency": latency}
+			if response != nil {
+				fields["status"] = response.StatusCode
+			}
+
+			level, message := LOG_LEVEL_INFO, "SoftLayer API call"
+			switch {
+			case err != nil:
+				level, message = LOG_LEVEL_ERROR, "SoftLayer API call failed"
+				fields["error"] = Redact(err.Error())
+			case response != nil && response.StatusCode >= 400:
+				level = LOG_LEVEL_WARN
+			}
+
+			if logger.IsEnabled(level) {
+				logger.Log(level, message, call.logFields(fields))
+			}
+
+			return response, err
+		}
+	}
+}
+
+type MetricsRecorder interface {
+	RecordCall(call *Call, response *Response, latency time.Duration, err error)
+}
+
+// MetricsMiddleware reports each call to the recorder, once per attempt when placed inside the retry middleware
+func MetricsMiddleware(recorder MetricsRecorder) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			start := time.Now()
+			response, err := next(ctx, call)
+
+			recorder.RecordCall(call, response, time.Since(start), err)
+
+			return response, err
+		}
+	}
+}
+
+// HeaderMiddleware adds static headers to every call, e.g. for tracing or auditing
+func HeaderMiddleware(header http.Header) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			for key, values := range header {
+				for _, value := range values {
+					call.Header.Add(key, value)
+				}
+			}
+
+			return next(ctx, call)
+		}
+	}
+}
+
+type CallMetrics struct {
+	Calls   int
+	Errors  int
+	Latency time.Duration
+}
+
+// InMemoryMetrics is a MetricsRecorder counting calls, errors (transport errors and HTTP error codes) and latency per service and method
+type InMemoryMetrics struct {
+	metrics map[string]CallMetrics
+	mutex   sync.Mutex
+}
+
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{
+		metrics: map[string]CallMetrics{},
+	}
+}
+
+func (m *InMemoryMetrics) RecordCall(call *Call, response *Response, latency time.Duration, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := call.Service + "#" + call.Method
+	metrics := m.metrics[key]
+
+	metrics.Calls++
+	metrics.Latency += latency
+	if err != nil || response == nil || response.StatusCode >= 400 {
+		metrics.Errors++
+	}
+
+	m.metrics[key] = metrics
+}
+
+func (m *InMemoryMetrics) Get(service string, method string) CallMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.metrics[service+"#"+method]
+}
+
+// Private methods
+
+func (c *Call) logFields(extraFields LogFields) LogFields {
+	fields := LogFields{
+		"service":     c.Service,
+		"method":      c.Method,
+		"http_method": c.HttpMethod,
+		"request_id":  c.RequestId,
+		"attempt":     c.Attempt,
+	}
+
+	for key, value := range extraFields {
+		fields[key] = value
+	}
+
+	return fields
+}
@@ -0,0 +1,161 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("Middleware", func() {
+	Context("#Chain", func() {
+		It("runs the first middleware outermost", func() {
+			order := []string{}
+			named := func(name string) slclient.Middleware {
+				return func(next slclient.Handler) slclient.Handler {
+					return func(ctx context.Context, call *slclient.Call) (*slclient.Response, error) {
+						order = append(order, name+" before")
+						response, err := next(ctx, call)
+						order = append(order, name+" after")
+						return response, err
+					}
+				}
+			}
+
+			handler := slclient.Chain(named("first"), named("second"))(func(ctx context.Context, call *slclient.Call) (*slclient.Response, error) {
+				order = append(order, "handler")
+				return &slclient.Response{StatusCode: 200}, nil
+			})
+
+			response, err := handler(context.Background(), &slclient.Call{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(order).To(Equal([]string{"first before", "second before", "handler", "second after", "first after"}))
+		})
+	})
+
+	Context("HttpClient", func() {
+		var (
+			server *httptest.Server
+
+			statusCodes []int
+			requests    int
+			headers     []http.Header
+		)
+
+		BeforeEach(func() {
+			statusCodes = []int{}
+			requests = 0
+			headers = []http.Header{}
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				headers = append(headers, r.Header)
+
+				statusCode := http.StatusOK
+				if requests < len(statusCodes) {
+					statusCode = statusCodes[requests]
+				}
+				requests++
+
+				w.WriteHeader(statusCode)
+				w.Write([]byte(`{}`))
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newHttpClient := func(options ...slclient.Option) *slclient.HttpClient {
+			options = append([]slclient.Option{slclient.WithBaseUrl(server.URL), slclient.WithLogger(slclient.NewNopLogger())}, options...)
+			httpClient := slclient.NewHttpClientWithOptions(slclient.NewBasicAuthenticator("fake-username", "fake-api-key"), "templates", options...)
+			httpClient.RetryPolicy.BaseDelay = 1 * time.Millisecond
+
+			return httpClient
+		}
+
+		It("adds the headers of a HeaderMiddleware to the requests", func() {
+			httpClient := newHttpClient(slclient.WithMiddlewares(slclient.HeaderMiddleware(http.Header{"X-Trace-Id": []string{"fake-trace-id"}})))
+
+			_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(headers[0].Get("X-Trace-Id")).To(Equal("fake-trace-id"))
+			Expect(headers[0].Get("Authorization")).ToNot(BeEmpty())
+		})
+
+		It("passes the service and method of the calls to the middlewares", func() {
+			calls := []slclient.Call{}
+			httpClient := newHttpClient()
+			httpClient.Use(func(next slclient.Handler) slclient.Handler {
+				return func(ctx context.Context, call *slclient.Call) (*slclient.Response, error) {
+					calls = append(calls, *call)
+					return next(ctx, call)
+				}
+			})
+
+			_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/getPowerState.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(calls[0].Method).To(Equal("getPowerState"))
+			Expect(calls[0].HttpMethod).To(Equal("GET"))
+			Expect(calls[0].RequestId).ToNot(BeEmpty())
+		})
+
+		It("lets a middleware answer without sending the request", func() {
+			httpClient := newHttpClient()
+			httpClient.Use(func(next slclient.Handler) slclient.Handler {
+				return func(ctx context.Context, call *slclient.Call) (*slclient.Response, error) {
+					return &slclient.Response{StatusCode: 200, Body: []byte(`"fake-body"`)}, nil
+				}
+			})
+
+			responseBody, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(string(responseBody)).To(Equal(`"fake-body"`))
+			Expect(requests).To(Equal(0))
+		})
+
+		It("records the calls once when outside of the retries", func() {
+			statusCodes = []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+			metrics := slclient.NewInMemoryMetrics()
+			httpClient := newHttpClient(slclient.WithMiddlewares(slclient.MetricsMiddleware(metrics)))
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/getObject.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(requests).To(Equal(3))
+
+			callMetrics := metrics.Get("SoftLayer_Virtual_Guest", "getObject")
+			Expect(callMetrics.Calls).To(Equal(1))
+			Expect(callMetrics.Errors).To(Equal(0))
+			Expect(callMetrics.Latency).To(BeNumerically(">", 0))
+		})
+
+		It("counts every attempt when chained inside a RetryMiddleware", func() {
+			statusCodes = []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+			metrics := slclient.NewInMemoryMetrics()
+
+			policy := slclient.DefaultRetryPolicy()
+			policy.BaseDelay = 1 * time.Millisecond
+
+			httpClient := newHttpClient(slclient.WithRetryPolicy(slclient.NoRetryPolicy()))
+			httpClient.Use(slclient.RetryMiddleware(policy, nil), slclient.MetricsMiddleware(metrics))
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account/getVirtualGuests.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+
+			callMetrics := metrics.Get("SoftLayer_Account", "getVirtualGuests")
+			Expect(callMetrics.Calls).To(Equal(3))
+			Expect(callMetrics.Errors).To(Equal(2))
+		})
+	})
+})
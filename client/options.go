@@ -0,0 +1,240 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	SOFTLAYER_PRIVATE_API_URL = "api.service.softlayer.com/rest/v3"
+
+	SOFTLAYER_XMLRPC_API_URL         = "api.softlayer.com/xmlrpc/v3"
+	SOFTLAYER_PRIVATE_XMLRPC_API_URL = "api.service.softlayer.com/xmlrpc/v3"
+
+	PROTOCOL_REST   = "rest"
+	PROTOCOL_XMLRPC = "xmlrpc"
+)
+
+// Options configures the endpoint and the HTTP transport of the clients, see the With* functions
+type Options struct {
+	//Host and path of the API endpoint, without the scheme, e.g. api.softlayer.com/rest/v3
+	ApiUrl   string
+	UseHttps bool
+
+	//PROTOCOL_REST or PROTOCOL_XMLRPC
+	Protocol string
+
+	//Used as is when set, the transport related options are then ignored
+	HTTPClient *http.Client
+
+	Transport http.RoundTripper
+	TLSConfig *tls.Config
+	Proxy     func(*http.Request) (*url.URL, error)
+
+	Timeout               time.Duration
+	ResponseHeaderTimeout time.Duration
+
+	RetryPolicy *RetryPolicy
+
+	//Requests per second, 0 for no rate limit
+	RateLimit      float64
+	RateLimitBurst int
+
+	//0 for no concurrency cap
+	MaxInFlight int
+
+	Logger Logger
+
+	Middlewares []Middleware
+}
+
+type Option func(*Options)
+
+func DefaultOptions() Options {
+	return Options{
+		ApiUrl:   SOFTLAYER_API_URL,
+		UseHttps: true,
+
+		Protocol: PROTOCOL_REST,
+
+		Logger: DefaultLogger(),
+	}
+}
+
+func NewOptions(options ...Option) Options {
+	o := DefaultOptions()
+	for _, option := range options {
+		option(&o)
+	}
+
+	return o
+}
+
+// WithBaseUrl sets the API endpoint, e.g. http://localhost:8080/rest/v3 or api.softlayer.com/rest/v3.
+// The scheme, when present, selects between HTTP and HTTPS.
+func WithBaseUrl(baseUrl string) Option {
+	return func(o *Options) {
+		apiUrl := strings.TrimSpace(baseUrl)
+
+		if parts := strings.SplitN(apiUrl, "://", 2); len(parts) == 2 {
+			o.UseHttps = !strings.EqualFold(parts[0], "http")
+			apiUrl = parts[1]
+		}
+
+		o.ApiUrl = strings.TrimSuffix(apiUrl, "/")
+	}
+}
+
+// WithScheme selects between http and https, anything but http means https
+func WithScheme(scheme string) Option {
+	return WithHttps(!strings.EqualFold(strings.TrimSuffix(scheme, "://"), "http"))
+}
+
+func WithHttps(useHttps bool) Option {
+	return func(o *Options) {
+		o.UseHttps = useHttps
+	}
+}
+
+// WithPrivateEndpoint uses the SoftLayer private network endpoint, only reachable from within SoftLayer
+func WithPrivateEndpoint() Option {
+	return func(o *Options) {
+		o.ApiUrl = SOFTLAYER_PRIVATE_API_URL
+		o.UseHttps = true
+	}
+}
+
+// WithXmlRpc sends the calls to the XML-RPC endpoint instead of the REST one, the default (or private)
+// REST endpoint is switched to its XML-RPC counterpart
+func WithXmlRpc() Option {
+	return WithProtocol(PROTOCOL_XMLRPC)
+}
+
+func WithProtocol(protocol string) Option {
+	return func(o *Options) {
+		o.Protocol = strings.ToLower(protocol)
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *Options) {
+		o.HTTPClient = httpClient
+	}
+}
+
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *Options) {
+		o.Transport = transport
+	}
+}
+
+// WithTLSConfig applies to the default transport, or to the transport given WithTransport when it is an *http.Transport
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(o *Options) {
+		o.TLSConfig = tlsConfig
+	}
+}
+
+// WithProxy sets the proxy of the transport, e.g. WithProxy(http.ProxyURL(proxyUrl))
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return func(o *Options) {
+		o.Proxy = proxy
+	}
+}
+
+// WithTimeout limits the total time of each HTTP request, including reading the response body
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+func WithResponseHeaderTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.ResponseHeaderTimeout = timeout
+	}
+}
+
+func WithRetryPolicy(retryPolicy RetryPolicy) Option {
+	return func(o *Options) {
+		o.RetryPolicy = &retryPolicy
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond requests per second on average, with bursts of up to burst requests
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(o *Options) {
+		o.RateLimit = requestsPerSecond
+		o.RateLimitBurst = burst
+	}
+}
+
+func WithMaxInFlight(maxInFlight int) Option {
+	return func(o *Options) {
+		o.MaxInFlight = maxInFlight
+	}
+}
+
+// WithLogger replaces the default stderr logger, use NewNopLogger() to disable logging
+func WithLogger(logger Logger) Option {
+	return func(o *Options) {
+		o.Logger = logger
+	}
+}
+
+func WithMiddlewares(middlewares ...Middleware) Option {
+	return func(o *Options) {
+		o.Middlewares = append(o.Middlewares, middlewares...)
+	}
+}
+
+// Private methods
+
+func (o Options) httpClient() *http.Client {
+	if o.HTTPClient != nil {
+		return o.HTTPClient
+	}
+
+	transport := o.transport()
+	if transport == nil && o.Timeout == 0 {
+		return http.DefaultClient
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   o.Timeout,
+	}
+}
+
+func (o Options) transport() http.RoundTripper {
+	if o.TLSConfig == nil && o.Proxy == nil && o.ResponseHeaderTimeout == 0 {
+		return o.Transport
+	}
+
+	var transport *http.Transport
+	switch t := o.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return o.Transport
+	}
+
+	if o.TLSConfig != nil {
+		transport.TLSClientConfig = o.TLSConfig
+	}
+
+	if o.Proxy != nil {
+		transport.Proxy = o.Proxy
+	}
+
+	if o.ResponseHeaderTimeout != 0 {
+		transport.ResponseHeaderTimeout = o.ResponseHeaderTimeout
+	}
+
+	return transport
+}
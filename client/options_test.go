@@ -0,0 +1,120 @@
+package client_test
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("Options", func() {
+	httpClientOf := func(client *slclient.SoftLayerClient) *slclient.HttpClient {
+		return client.GetHttpClient().(*slclient.HttpClient)
+	}
+
+	Context("endpoint", func() {
+		It("defaults to the public HTTPS endpoint", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key")
+			Expect(httpClientOf(client).BaseUrl()).To(Equal("https://api.softlayer.com/rest/v3"))
+		})
+
+		It("uses the private network endpoint", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithPrivateEndpoint())
+			Expect(httpClientOf(client).BaseUrl()).To(Equal("https://api.service.softlayer.com/rest/v3"))
+		})
+
+		It("takes the scheme of a custom base URL", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithBaseUrl("http://localhost:8080/rest/v3/"))
+			Expect(httpClientOf(client).BaseUrl()).To(Equal("http://localhost:8080/rest/v3"))
+		})
+
+		It("keeps HTTPS for base URLs without a scheme unless told otherwise", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithBaseUrl("localhost:8080/rest/v3"))
+			Expect(httpClientOf(client).BaseUrl()).To(Equal("https://localhost:8080/rest/v3"))
+
+			client = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithBaseUrl("localhost:8080/rest/v3"), slclient.WithScheme("http"))
+			Expect(httpClientOf(client).BaseUrl()).To(Equal("http://localhost:8080/rest/v3"))
+		})
+
+		It("sends the requests to the custom base URL", func() {
+			paths := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithBaseUrl(server.URL+"/rest/v3"))
+
+			_, errorCode, err := client.GetHttpClient().DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(paths).To(Equal([]string{"/rest/v3/SoftLayer_Account.json"}))
+		})
+	})
+
+	Context("transport", func() {
+		It("uses the default HTTP client without transport options", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key")
+			Expect(httpClientOf(client).HTTPClient).To(BeIdenticalTo(http.DefaultClient))
+		})
+
+		It("uses the given HTTP client as is", func() {
+			httpClient := &http.Client{}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithHTTPClient(httpClient), slclient.WithTimeout(1*time.Second))
+			Expect(httpClientOf(client).HTTPClient).To(BeIdenticalTo(httpClient))
+		})
+
+		It("applies the timeout, TLS config and proxy to a copy of the default transport", func() {
+			tlsConfig := &tls.Config{InsecureSkipVerify: true}
+			proxyUrl, _ := url.Parse("http://proxy.example.com:3128")
+
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithTimeout(30*time.Second),
+				slclient.WithResponseHeaderTimeout(10*time.Second),
+				slclient.WithTLSConfig(tlsConfig),
+				slclient.WithProxy(http.ProxyURL(proxyUrl)),
+			)
+
+			httpClient := httpClientOf(client).HTTPClient
+			Expect(httpClient).ToNot(BeIdenticalTo(http.DefaultClient))
+			Expect(httpClient.Timeout).To(Equal(30 * time.Second))
+
+			transport, ok := httpClient.Transport.(*http.Transport)
+			Expect(ok).To(BeTrue())
+			Expect(transport).ToNot(BeIdenticalTo(http.DefaultTransport))
+			Expect(transport.TLSClientConfig).To(BeIdenticalTo(tlsConfig))
+			Expect(transport.ResponseHeaderTimeout).To(Equal(10 * time.Second))
+
+			req, _ := http.NewRequest("GET", "https://api.softlayer.com/rest/v3", nil)
+			proxy, err := transport.Proxy(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proxy).To(Equal(proxyUrl))
+		})
+
+		It("applies the TLS config to a custom *http.Transport without modifying it", func() {
+			customTransport := &http.Transport{MaxIdleConns: 7}
+			tlsConfig := &tls.Config{ServerName: "api.service.softlayer.com"}
+
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithTransport(customTransport), slclient.WithTLSConfig(tlsConfig))
+
+			transport := httpClientOf(client).HTTPClient.Transport.(*http.Transport)
+			Expect(transport.MaxIdleConns).To(Equal(7))
+			Expect(transport.TLSClientConfig).To(BeIdenticalTo(tlsConfig))
+			Expect(customTransport.TLSClientConfig).ToNot(BeIdenticalTo(tlsConfig))
+		})
+	})
+
+	Context("retry policy", func() {
+		It("replaces the default retry policy", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithRetryPolicy(slclient.NoRetryPolicy()))
+			Expect(httpClientOf(client).RetryPolicy).To(Equal(slclient.NoRetryPolicy()))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package client_prometheus
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+// Collector is a prometheus.Collector and a client MetricsRecorder counting the SoftLayer API calls per service, method
+// and status code (softlayer_api_requests_total) and observing their latency per service and method
+// (softlayer_api_request_duration_seconds), the same metrics as slclient.PrometheusTextMetrics, e.g.
+//
+//	collector := client_prometheus.NewCollector()
+//	prometheus.MustRegister(collector)
+//
+//	client := slclient.NewSoftLayerClient(username, apiKey, slclient.WithMetrics(collector))
+type Collector struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: slclient.PROMETHEUS_NAMESPACE,
+			Name:      "requests_total",
+			Help:      "Number of SoftLayer API calls by service, method and status code.",
+		}, []string{"service", "method", "code"}),
+
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: slclient.PROMETHEUS_NAMESPACE,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the SoftLayer API calls by service and method.",
+			Buckets:   slclient.PROMETHEUS_DEFAULT_BUCKETS,
+		}, []string{"service", "method"}),
+	}
+}
+
+func (c *Collector) RecordCall(call *slclient.Call, response *slclient.Response, latency time.Duration, err error) {
+	code := "error"
+	if response != nil && response.StatusCode != 0 {
+		code = strconv.Itoa(response.StatusCode)
+	}
+
+	c.requests.WithLabelValues(call.Service, call.Method, code).Inc()
+	c.latency.WithLabelValues(call.Service, call.Method).Observe(latency.Seconds())
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.latency.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.latency.Collect(ch)
+}
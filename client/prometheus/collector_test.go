@@ -0,0 +1,121 @@
+package client_prometheus_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	slprometheus "github.com/maximilien/softlayer-go/client/prometheus"
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		server     *httptest.Server
+		statusCode int
+
+		collector *slprometheus.Collector
+		registry  *prometheus.Registry
+		client    *slclient.SoftLayerClient
+	)
+
+	BeforeEach(func() {
+		statusCode = http.StatusOK
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCode)
+			w.Write([]byte(`{}`))
+		}))
+
+		collector = slprometheus.NewCollector()
+
+		registry = prometheus.NewRegistry()
+		Expect(registry.Register(collector)).To(Succeed())
+
+		client = slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+			slclient.WithBaseUrl(server.URL),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithRetryPolicy(slclient.NoRetryPolicy()),
+			slclient.WithMetrics(collector),
+		)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	gather := func() map[string]*dto.MetricFamily {
+		families, err := registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+
+		byName := map[string]*dto.MetricFamily{}
+		for _, family := range families {
+			byName[family.GetName()] = family
+		}
+
+		return byName
+	}
+
+	labels := func(metric *dto.Metric) map[string]string {
+		byName := map[string]string{}
+		for _, label := range metric.GetLabel() {
+			byName[label.GetName()] = label.GetValue()
+		}
+
+		return byName
+	}
+
+	It("can be registered next to other collectors", func() {
+		Expect(registry.Register(prometheus.NewGoCollector())).To(Succeed())
+		Expect(gather()).To(HaveKey("go_goroutines"))
+	})
+
+	It("counts the calls by service name, method and status code", func() {
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = virtualGuestService.GetObject(1234)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = virtualGuestService.GetObject(1234)
+		Expect(err).ToNot(HaveOccurred())
+
+		statusCode = http.StatusNotFound
+		_, err = virtualGuestService.GetObject(1234)
+		Expect(err).To(HaveOccurred())
+
+		families := gather()
+		Expect(families).To(HaveKey("softlayer_api_requests_total"))
+
+		counts := map[string]float64{}
+		for _, metric := range families["softlayer_api_requests_total"].GetMetric() {
+			metricLabels := labels(metric)
+			Expect(metricLabels["service"]).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(metricLabels["method"]).To(Equal("getObject"))
+
+			counts[metricLabels["code"]] = metric.GetCounter().GetValue()
+		}
+		Expect(counts).To(Equal(map[string]float64{"200": 2, "404": 1}))
+	})
+
+	It("observes the latency of the calls by service name and method", func() {
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = virtualGuestService.GetObject(1234)
+		Expect(err).ToNot(HaveOccurred())
+
+		families := gather()
+		Expect(families).To(HaveKey("softlayer_api_request_duration_seconds"))
+
+		metrics := families["softlayer_api_request_duration_seconds"].GetMetric()
+		Expect(metrics).To(HaveLen(1))
+		Expect(labels(metrics[0])).To(Equal(map[string]string{"service": "SoftLayer_Virtual_Guest", "method": "getObject"}))
+		Expect(metrics[0].GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+		Expect(metrics[0].GetHistogram().GetBucket()).To(HaveLen(len(slclient.PROMETHEUS_DEFAULT_BUCKETS)))
+	})
+})
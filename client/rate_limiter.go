@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket shared by all the requests of a client: Rate tokens are added per second,
+// up to Burst, and each request takes one
+type RateLimiter struct {
+	Rate  float64
+	Burst int
+
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+
+	mutex sync.Mutex
+}
+
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		Rate:  rate,
+		Burst: burst,
+
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available (and any pause is over) or the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// PauseUntil holds back all the requests until the given time, e.g. when SoftLayer answers with a Retry-After header
+func (l *RateLimiter) PauseUntil(until time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// ConcurrencyLimiter caps the number of requests in flight
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+}
+
+func NewConcurrencyLimiter(maxInFlight int) *ConcurrencyLimiter {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	return &ConcurrencyLimiter{
+		slots: make(chan struct{}, maxInFlight),
+	}
+}
+
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *ConcurrencyLimiter) Release() {
+	<-l.slots
+}
+
+func (l *ConcurrencyLimiter) InFlight() int {
+	return len(l.slots)
+}
+
+func (l *ConcurrencyLimiter) MaxInFlight() int {
+	return cap(l.slots)
+}
+
+// Private methods
+
+// reserve takes a token and returns 0, or returns how long to wait before trying again
+func (l *RateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	if l.Rate <= 0 {
+		return 0
+	}
+
+	l.tokens = math.Min(float64(l.Burst), l.tokens+now.Sub(l.last).Seconds()*l.Rate)
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.Rate * float64(time.Second))
+}
+
+// Private functions
+
+// retryAfter parses the Retry-After header, in seconds or as an HTTP date
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
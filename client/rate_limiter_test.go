@@ -0,0 +1,173 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("RateLimiter", func() {
+	Context("#Wait", func() {
+		It("lets bursts through and then spaces the requests at the rate", func() {
+			limiter := slclient.NewRateLimiter(20, 2)
+
+			start := time.Now()
+			for i := 0; i < 4; i++ {
+				Expect(limiter.Wait(context.Background())).To(Succeed())
+			}
+
+			Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+			Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+		})
+
+		It("holds the requests back until the end of a pause", func() {
+			limiter := slclient.NewRateLimiter(1000, 10)
+			limiter.PauseUntil(time.Now().Add(100 * time.Millisecond))
+
+			start := time.Now()
+			Expect(limiter.Wait(context.Background())).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+		})
+
+		It("stops waiting when the context is done", func() {
+			limiter := slclient.NewRateLimiter(0.1, 1)
+			Expect(limiter.Wait(context.Background())).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			Expect(limiter.Wait(ctx)).To(Equal(context.DeadlineExceeded))
+		})
+	})
+
+	Context("HttpClient", func() {
+		var (
+			server *httptest.Server
+
+			mutex       sync.Mutex
+			inFlight    int
+			maxInFlight int
+			requests    int
+			statusCodes []int
+			retryAfters []string
+		)
+
+		BeforeEach(func() {
+			inFlight = 0
+			maxInFlight = 0
+			requests = 0
+			statusCodes = []int{}
+			retryAfters = []string{}
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+
+				statusCode := http.StatusOK
+				if requests < len(statusCodes) {
+					statusCode = statusCodes[requests]
+				}
+				if requests < len(retryAfters) && retryAfters[requests] != "" {
+					w.Header().Set("Retry-After", retryAfters[requests])
+				}
+				requests++
+				mutex.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+
+				w.WriteHeader(statusCode)
+				w.Write([]byte(`{}`))
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newHttpClient := func(options ...slclient.Option) *slclient.HttpClient {
+			options = append([]slclient.Option{slclient.WithBaseUrl(server.URL), slclient.WithLogger(slclient.NewNopLogger())}, options...)
+			return slclient.NewHttpClientWithOptions(slclient.NewBasicAuthenticator("fake-username", "fake-api-key"), "templates", options...)
+		}
+
+		It("caps the number of requests in flight", func() {
+			httpClient := newHttpClient(slclient.WithMaxInFlight(2))
+			Expect(httpClient.ConcurrencyLimiter.MaxInFlight()).To(Equal(2))
+
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234/getPowerState.json", "GET", nil)
+					Expect(err).ToNot(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			Expect(requests).To(Equal(8))
+			Expect(maxInFlight).To(Equal(2))
+			Expect(httpClient.ConcurrencyLimiter.InFlight()).To(Equal(0))
+		})
+
+		It("rate limits the requests", func() {
+			httpClient := newHttpClient(slclient.WithRateLimit(50, 1))
+			Expect(httpClient.RateLimiter).ToNot(BeNil())
+
+			start := time.Now()
+			for i := 0; i < 3; i++ {
+				_, _, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(time.Since(start)).To(BeNumerically(">=", 40*time.Millisecond))
+		})
+
+		It("waits for Retry-After before retrying a 429", func() {
+			statusCodes = []int{http.StatusTooManyRequests}
+			retryAfters = []string{"1"}
+
+			httpClient := newHttpClient()
+			httpClient.RetryPolicy.BaseDelay = 1 * time.Millisecond
+
+			start := time.Now()
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(requests).To(Equal(2))
+			Expect(time.Since(start)).To(BeNumerically(">=", 1*time.Second))
+		})
+
+		It("pauses the other requests of the client after a Retry-After", func() {
+			statusCodes = []int{http.StatusTooManyRequests}
+			retryAfters = []string{"1"}
+
+			httpClient := newHttpClient(slclient.WithRateLimit(1000, 10), slclient.WithRetryPolicy(slclient.NoRetryPolicy()))
+
+			_, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/createObject.json", "POST", bytes.NewBufferString("{}"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(429))
+
+			start := time.Now()
+			_, _, err = httpClient.DoRawHttpRequest("SoftLayer_Account.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"text/template"
+)
+
+//go:embed templates
+var requestTemplates embed.FS
+
+var requestTemplateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// generateRequestBody renders the template of templatePath with the data: one of the templates embedded from the
+// templates directory (e.g. "parameters.json"), otherwise a template file. The data is marshaled to JSON when
+// templatePath is empty or the templates directory (TEMPLATE_ROOT_PATH).
+func generateRequestBody(templatePath string, templateData interface{}) (*bytes.Buffer, error) {
+	if templatePath == "" || templatePath == TEMPLATE_ROOT_PATH {
+		body, err := json.Marshal(templateData)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.NewBuffer(body), nil
+	}
+
+	bodyTemplate, err := parseRequestTemplate(templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	body := new(bytes.Buffer)
+	err = bodyTemplate.Execute(body, templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func parseRequestTemplate(templatePath string) (*template.Template, error) {
+	embeddedPath := path.Join(TEMPLATE_ROOT_PATH, templatePath)
+	if info, err := fs.Stat(requestTemplates, embeddedPath); err == nil && !info.IsDir() {
+		return template.New(path.Base(embeddedPath)).Funcs(requestTemplateFuncs).ParseFS(requestTemplates, embeddedPath)
+	}
+
+	if info, err := os.Stat(templatePath); err == nil && !info.IsDir() {
+		return template.New(filepath.Base(templatePath)).Funcs(requestTemplateFuncs).ParseFiles(templatePath)
+	}
+
+	return nil, errors.New(fmt.Sprintf("softlayer-go cannot find the request body template '%s'", templatePath))
+}
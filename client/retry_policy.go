@@ -0,0 +1,158 @@
+package client
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_RETRY_MAX_ATTEMPTS = 3
+	DEFAULT_RETRY_BASE_DELAY   = 500 * time.Millisecond
+	DEFAULT_RETRY_MAX_DELAY    = 10 * time.Second
+	DEFAULT_RETRY_JITTER       = 0.5
+)
+
+// SoftLayer API methods that must never be replayed automatically, whatever the policy says
+var NEVER_RETRY_METHODS = []string{"placeOrder"}
+
+type RetryPolicy struct {
+	//Total number of attempts, including the first one. Values lower than 2 disable retries.
+	MaxAttempts int
+
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	//Fraction (0.0 to 1.0) of each delay that is randomized
+	Jitter float64
+
+	RetryableStatusCodes []int
+	RetryableVerbs       []string
+
+	//SoftLayer API method names (e.g. createObject) that are not idempotent and are never retried
+	NonIdempotentMethods []string
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DEFAULT_RETRY_MAX_ATTEMPTS,
+
+		BaseDelay: DEFAULT_RETRY_BASE_DELAY,
+		MaxDelay:  DEFAULT_RETRY_MAX_DELAY,
+
+		Jitter: DEFAULT_RETRY_JITTER,
+
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableVerbs: []string{"GET", "HEAD", "OPTIONS"},
+
+		NonIdempotentMethods: []string{"placeOrder", "placeQuote", "createObject", "createObjects"},
+	}
+}
+
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+func (p RetryPolicy) IsRetryableRequest(requestType string, requestPath string) bool {
+	if p.MaxAttempts < 2 {
+		return false
+	}
+
+	if !containsString(p.RetryableVerbs, strings.ToUpper(requestType)) {
+		return false
+	}
+
+	method := softLayerMethodName(requestType, requestPath)
+	if containsString(NEVER_RETRY_METHODS, method) || containsString(p.NonIdempotentMethods, method) {
+		return false
+	}
+
+	return true
+}
+
+func (p RetryPolicy) IsRetryableStatusCode(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Delay to wait before the given retry attempt (starting at 1), exponential and capped at MaxDelay
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 || p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	jitter := math.Min(math.Max(p.Jitter, 0), 1)
+	delay -= delay * jitter * rand.Float64()
+
+	return time.Duration(delay)
+}
+
+// Private functions
+
+// softLayerMethodName is the SoftLayer API method of a REST call, implied by the verb when the path has none:
+// a POST to SoftLayer_Virtual_Guest.json is a createObject and a DELETE of SoftLayer_Virtual_Guest/1234.json a deleteObject
+func softLayerMethodName(requestType string, requestPath string) string {
+	requestPath = strings.SplitN(requestPath, "?", 2)[0]
+
+	segments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "SoftLayer_") {
+			segments = segments[i+1:]
+			break
+		}
+	}
+
+	method := ""
+	for _, segment := range segments {
+		segment = strings.TrimSuffix(segment, ".json")
+		if _, err := strconv.Atoi(segment); err == nil || segment == "" {
+			continue
+		}
+
+		method = segment
+	}
+
+	if method != "" {
+		return method
+	}
+
+	switch strings.ToUpper(requestType) {
+	case "POST":
+		return "createObject"
+	case "PUT":
+		return "editObject"
+	case "DELETE":
+		return "deleteObject"
+	}
+
+	return "getObject"
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("RetryPolicy", func() {
+	var policy slclient.RetryPolicy
+
+	BeforeEach(func() {
+		policy = slclient.DefaultRetryPolicy()
+	})
+
+	Context("#IsRetryableRequest", func() {
+		It("retries idempotent verbs", func() {
+			Expect(policy.IsRetryableRequest("GET", "SoftLayer_Account/getVirtualGuests.json")).To(BeTrue())
+			Expect(policy.IsRetryableRequest("head", "SoftLayer_Account.json")).To(BeTrue())
+		})
+
+		It("does not retry verbs which are not configured", func() {
+			Expect(policy.IsRetryableRequest("POST", "SoftLayer_Virtual_Guest/1234/setTags.json")).To(BeFalse())
+			Expect(policy.IsRetryableRequest("DELETE", "SoftLayer_Virtual_Guest/1234.json")).To(BeFalse())
+		})
+
+		It("does not retry non idempotent SoftLayer methods", func() {
+			policy.RetryableVerbs = []string{"GET", "POST"}
+			Expect(policy.IsRetryableRequest("POST", "SoftLayer_Virtual_Guest/1234/setTags.json")).To(BeTrue())
+			Expect(policy.IsRetryableRequest("POST", "SoftLayer_Virtual_Guest/createObjects.json")).To(BeFalse())
+			Expect(policy.IsRetryableRequest("POST", "SoftLayer_Product_Order/placeQuote.json")).To(BeFalse())
+		})
+
+		It("resolves the methods implied by the verb of the REST paths", func() {
+			policy.RetryableVerbs = []string{"GET", "POST", "PUT", "DELETE"}
+			policy.NonIdempotentMethods = []string{"createObject", "editObject", "deleteObject"}
+
+			Expect(policy.IsRetryableRequest("POST", "SoftLayer_Virtual_Guest.json")).To(BeFalse())
+			Expect(policy.IsRetryableRequest("POST", "https://api.softlayer.com/rest/v3/SoftLayer_Virtual_Guest.json?objectMask=id")).To(BeFalse())
+			Expect(policy.IsRetryableRequest("PUT", "SoftLayer_Dns_Domain_ResourceRecord/1234.json")).To(BeFalse())
+			Expect(policy.IsRetryableRequest("DELETE", "SoftLayer_Virtual_Guest/1234.json")).To(BeFalse())
+			Expect(policy.IsRetryableRequest("GET", "SoftLayer_Virtual_Guest/1234.json")).To(BeTrue())
+			Expect(policy.IsRetryableRequest("GET", "SoftLayer_Virtual_Guest/1234/getPowerState.json")).To(BeTrue())
+		})
+
+		It("never retries placeOrder", func() {
+			policy.RetryableVerbs = []string{"POST"}
+			policy.NonIdempotentMethods = []string{}
+			Expect(policy.IsRetryableRequest("POST", "https://api.softlayer.com/rest/v3/SoftLayer_Product_Order/placeOrder.json")).To(BeFalse())
+		})
+
+		It("does not retry when MaxAttempts is lower than 2", func() {
+			Expect(slclient.NoRetryPolicy().IsRetryableRequest("GET", "SoftLayer_Account.json")).To(BeFalse())
+		})
+	})
+
+	Context("#IsRetryableStatusCode", func() {
+		It("retries transient server errors", func() {
+			for _, code := range []int{429, 500, 502, 503, 504} {
+				Expect(policy.IsRetryableStatusCode(code)).To(BeTrue())
+			}
+		})
+
+		It("does not retry other status codes", func() {
+			for _, code := range []int{200, 400, 401, 404, 501} {
+				Expect(policy.IsRetryableStatusCode(code)).To(BeFalse())
+			}
+		})
+	})
+
+	Context("#Backoff", func() {
+		BeforeEach(func() {
+			policy.BaseDelay = 100 * time.Millisecond
+			policy.MaxDelay = 1 * time.Second
+			policy.Jitter = 0
+		})
+
+		It("grows exponentially", func() {
+			Expect(policy.Backoff(1)).To(Equal(100 * time.Millisecond))
+			Expect(policy.Backoff(2)).To(Equal(200 * time.Millisecond))
+			Expect(policy.Backoff(3)).To(Equal(400 * time.Millisecond))
+		})
+
+		It("is capped at MaxDelay", func() {
+			Expect(policy.Backoff(10)).To(Equal(1 * time.Second))
+		})
+
+		It("randomizes the delay with jitter", func() {
+			policy.Jitter = 0.5
+			for i := 0; i < 20; i++ {
+				delay := policy.Backoff(2)
+				Expect(delay).To(BeNumerically(">=", 100*time.Millisecond))
+				Expect(delay).To(BeNumerically("<=", 200*time.Millisecond))
+			}
+		})
+	})
+})
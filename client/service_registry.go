@@ -0,0 +1,38 @@
+package client
+
+import (
+	"sync"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var (
+	serviceFactoriesLock sync.RWMutex
+	serviceFactories     = map[string]softlayer.ServiceFactory{}
+)
+
+// RegisterService makes the clients created afterwards return the service built by the factory for the name from
+// GetService, replacing any service of the same name, e.g.
+//
+//	client.RegisterService("SoftLayer_Ticket", func(c softlayer.Client) softlayer.Service {
+//		return NewTicketService(c)
+//	})
+func RegisterService(name string, factory softlayer.ServiceFactory) {
+	serviceFactoriesLock.Lock()
+	defer serviceFactoriesLock.Unlock()
+
+	serviceFactories[name] = factory
+}
+
+// RegisteredServices returns the factories of RegisterService by service name
+func RegisteredServices() map[string]softlayer.ServiceFactory {
+	serviceFactoriesLock.RLock()
+	defer serviceFactoriesLock.RUnlock()
+
+	factories := map[string]softlayer.ServiceFactory{}
+	for name, factory := range serviceFactories {
+		factories[name] = factory
+	}
+
+	return factories
+}
@@ -0,0 +1,60 @@
+package client_simulator
+
+import (
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	ORDER_STATUS_APPROVED = "APPROVED"
+	ORDER_STATUS_COMPLETE = "COMPLETE"
+)
+
+func init() {
+	route("SoftLayer_Billing_Order", "getObject", (*Simulator).getBillingOrder)
+}
+
+// Private methods
+
+func (s *Simulator) newBillingOrder(orderId int, status string) *datatypes.SoftLayer_Billing_Order {
+	now := time.Now()
+
+	order := &datatypes.SoftLayer_Billing_Order{
+		AccountId:  FIRST_ID,
+		CreateDate: &now,
+		Id:         orderId,
+		ModifyDate: &now,
+		Status:     status,
+	}
+
+	s.billingOrders[orderId] = order
+
+	return order
+}
+
+// addBillingOrderItem adds a top level item to the order, billing the resource with the billing item
+func (s *Simulator) addBillingOrderItem(order *datatypes.SoftLayer_Billing_Order, categoryCode string, billingItemId int, resourceId int) {
+	item := datatypes.SoftLayer_Billing_Order_Item{
+		CategoryCode: categoryCode,
+		Id:           s.newId(),
+		OrderId:      order.Id,
+		BillingItem: &datatypes.SoftLayer_Billing_Item{
+			Id:              billingItemId,
+			CategoryCode:    categoryCode,
+			ResourceTableId: resourceId,
+		},
+	}
+
+	order.Items = append(order.Items, item)
+	order.OrderTopLevelItems = append(order.OrderTopLevelItems, item)
+}
+
+func (s *Simulator) getBillingOrder(r *request) (interface{}, error) {
+	order, ok := s.billingOrders[r.id]
+	if !ok {
+		return nil, notFound(r)
+	}
+
+	return toMap(order), nil
+}
@@ -0,0 +1,278 @@
+package client_simulator
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const DEFAULT_RESOURCE_RECORD_TTL = 86400
+
+func init() {
+	route("SoftLayer_Dns_Domain", "createObject", (*Simulator).createDnsDomain)
+	route("SoftLayer_Dns_Domain", "getObject", (*Simulator).getDnsDomain)
+	route("SoftLayer_Dns_Domain", "deleteObject", (*Simulator).deleteDnsDomain)
+	route("SoftLayer_Dns_Domain", "getResourceRecords", (*Simulator).getDnsDomainResourceRecords)
+	route("SoftLayer_Dns_Domain", "getByDomainName", (*Simulator).getDnsDomainsByName)
+
+	for _, service := range []string{"SoftLayer_Dns_Domain_ResourceRecord", "SoftLayer_Dns_Domain_ResourceRecord_SrvType"} {
+		route(service, "createObject", (*Simulator).createResourceRecord)
+		route(service, "getObject", (*Simulator).getResourceRecord)
+		route(service, "editObject", (*Simulator).editResourceRecord)
+		route(service, "deleteObject", (*Simulator).deleteResourceRecord)
+	}
+
+	route("SoftLayer_Account", "getDomains", (*Simulator).getAccountDomains)
+}
+
+// Private types
+
+type dnsDomain struct {
+	domain datatypes.SoftLayer_Dns_Domain
+}
+
+type resourceRecord struct {
+	record datatypes.SoftLayer_Dns_Domain_ResourceRecord
+}
+
+// Private methods
+
+func (s *Simulator) findDnsDomain(r *request) (*dnsDomain, error) {
+	domain, ok := s.dnsDomains[r.id]
+	if !ok {
+		return nil, notFound(r)
+	}
+
+	return domain, nil
+}
+
+func (s *Simulator) findResourceRecord(r *request) (*resourceRecord, error) {
+	record, ok := s.resourceRecords[r.id]
+	if !ok {
+		return nil, notFound(r)
+	}
+
+	return record, nil
+}
+
+func (s *Simulator) addResourceRecord(record datatypes.SoftLayer_Dns_Domain_ResourceRecord) *resourceRecord {
+	record.Id = s.newId()
+	if record.Ttl == 0 {
+		record.Ttl = DEFAULT_RESOURCE_RECORD_TTL
+	}
+
+	added := &resourceRecord{record: record}
+	s.resourceRecords[record.Id] = added
+
+	if domain, ok := s.dnsDomains[record.DomainId]; ok {
+		domain.touch()
+	}
+
+	return added
+}
+
+// dnsDomainToMap adds the records of the domain, as SoftLayer keeps them apart
+func (s *Simulator) dnsDomainToMap(domain *dnsDomain) map[string]interface{} {
+	records := s.dnsDomainResourceRecords(domain.domain.Id)
+
+	result := toMap(domain.domain)
+	result["resourceRecordCount"] = len(records)
+	result["resourceRecords"] = records
+
+	return result
+}
+
+func (s *Simulator) dnsDomainResourceRecords(domainId int) []interface{} {
+	ids := []int{}
+	for id, record := range s.resourceRecords {
+		if record.record.DomainId == domainId {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	records := []interface{}{}
+	for _, id := range ids {
+		records = append(records, toMap(s.resourceRecords[id].record))
+	}
+
+	return records
+}
+
+func (d *dnsDomain) touch() {
+	d.domain.Serial++
+	d.domain.UpdateDate = time.Now().Format(time.RFC3339)
+}
+
+func (s *Simulator) createDnsDomain(r *request) (interface{}, error) {
+	template := datatypes.SoftLayer_Dns_Domain_Template{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, err
+	}
+
+	if template.Name == "" {
+		return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_MissingCreationProperty", "Name is required.")
+	}
+
+	for _, domain := range s.dnsDomains {
+		if domain.domain.Name == template.Name {
+			return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_Dns_Domain_DuplicateDomain", "Domain '"+template.Name+"' already exists.")
+		}
+	}
+
+	domain := &dnsDomain{
+		domain: datatypes.SoftLayer_Dns_Domain{
+			Id:   s.newId(),
+			Name: template.Name,
+		},
+	}
+	domain.touch()
+	s.dnsDomains[domain.domain.Id] = domain
+
+	for _, record := range template.ResourceRecords {
+		record.DomainId = domain.domain.Id
+		s.addResourceRecord(record)
+	}
+
+	return s.dnsDomainToMap(domain), nil
+}
+
+func (s *Simulator) getDnsDomain(r *request) (interface{}, error) {
+	domain, err := s.findDnsDomain(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dnsDomainToMap(domain), nil
+}
+
+func (s *Simulator) deleteDnsDomain(r *request) (interface{}, error) {
+	domain, err := s.findDnsDomain(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, record := range s.resourceRecords {
+		if record.record.DomainId == domain.domain.Id {
+			delete(s.resourceRecords, id)
+		}
+	}
+	delete(s.dnsDomains, domain.domain.Id)
+
+	return true, nil
+}
+
+func (s *Simulator) getDnsDomainResourceRecords(r *request) (interface{}, error) {
+	domain, err := s.findDnsDomain(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dnsDomainResourceRecords(domain.domain.Id), nil
+}
+
+func (s *Simulator) getDnsDomainsByName(r *request) (interface{}, error) {
+	name := ""
+	if err := r.parameter(0, &name); err != nil {
+		return nil, err
+	}
+
+	domains := []interface{}{}
+	for _, domain := range s.sortedDnsDomains() {
+		if domain.domain.Name == name {
+			domains = append(domains, s.dnsDomainToMap(domain))
+		}
+	}
+
+	return domains, nil
+}
+
+func (s *Simulator) getAccountDomains(r *request) (interface{}, error) {
+	domains := []interface{}{}
+	for _, domain := range s.sortedDnsDomains() {
+		domains = append(domains, s.dnsDomainToMap(domain))
+	}
+
+	return domains, nil
+}
+
+func (s *Simulator) sortedDnsDomains() []*dnsDomain {
+	ids := []int{}
+	for id := range s.dnsDomains {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	domains := []*dnsDomain{}
+	for _, id := range ids {
+		domains = append(domains, s.dnsDomains[id])
+	}
+
+	return domains
+}
+
+func (s *Simulator) createResourceRecord(r *request) (interface{}, error) {
+	record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	if err := r.parameter(0, &record); err != nil {
+		return nil, err
+	}
+
+	if record.Host == "" || record.Data == "" || record.Type == "" {
+		return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_MissingCreationProperty", "Host, data and type are required.")
+	}
+
+	if _, ok := s.dnsDomains[record.DomainId]; !ok {
+		return nil, newError(http.StatusNotFound, common.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND, "Unable to find the domain of the resource record.")
+	}
+
+	return toMap(s.addResourceRecord(record).record), nil
+}
+
+func (s *Simulator) getResourceRecord(r *request) (interface{}, error) {
+	record, err := s.findResourceRecord(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMap(record.record), nil
+}
+
+func (s *Simulator) editResourceRecord(r *request) (interface{}, error) {
+	record, err := s.findResourceRecord(r)
+	if err != nil {
+		return nil, err
+	}
+
+	template := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, err
+	}
+
+	template.Id = record.record.Id
+	template.DomainId = record.record.DomainId
+	record.record = template
+
+	if domain, ok := s.dnsDomains[record.record.DomainId]; ok {
+		domain.touch()
+	}
+
+	return true, nil
+}
+
+func (s *Simulator) deleteResourceRecord(r *request) (interface{}, error) {
+	record, err := s.findResourceRecord(r)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.resourceRecords, record.record.Id)
+
+	if domain, ok := s.dnsDomains[record.record.DomainId]; ok {
+		domain.touch()
+	}
+
+	return true, nil
+}
@@ -0,0 +1,130 @@
+package client_simulator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Private functions
+
+// matchesFilter tells whether a value in its generic JSON form matches an object filter node: properties are
+// matched recursively, lists match when any of their items does, operations are evaluated on the leaf values
+func matchesFilter(value interface{}, filter map[string]interface{}) bool {
+	if list, ok := value.([]interface{}); ok {
+		for _, item := range list {
+			if matchesFilter(item, filter) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for key, subFilter := range filter {
+		switch key {
+		case "operation":
+			if !matchesOperation(value, subFilter, filter["options"]) {
+				return false
+			}
+		case "options":
+		default:
+			propertyFilter, ok := subFilter.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			object, _ := value.(map[string]interface{})
+			if !matchesFilter(object[key], propertyFilter) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func matchesOperation(value interface{}, operation interface{}, options interface{}) bool {
+	operationString, ok := operation.(string)
+	if !ok {
+		return value != nil && valueString(value) == valueString(operation)
+	}
+
+	switch operationString {
+	case "is null":
+		return value == nil
+	case "not null":
+		return value != nil
+	case "in":
+		for _, option := range optionValues(options, "data") {
+			if value != nil && valueString(value) == valueString(option) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if value == nil {
+		return false
+	}
+
+	actual := valueString(value)
+	for _, operator := range []string{"*=", "^=", "$=", "!=", ">", "<", "~"} {
+		if !strings.HasPrefix(operationString, operator+" ") {
+			continue
+		}
+
+		expected := strings.TrimPrefix(operationString, operator+" ")
+		switch operator {
+		case "*=":
+			return strings.Contains(strings.ToLower(actual), strings.ToLower(expected))
+		case "^=":
+			return strings.HasPrefix(strings.ToLower(actual), strings.ToLower(expected))
+		case "$=":
+			return strings.HasSuffix(strings.ToLower(actual), strings.ToLower(expected))
+		case "!=":
+			return actual != expected
+		case "~":
+			return strings.EqualFold(actual, expected)
+		case ">", "<":
+			actualNumber, err1 := strconv.ParseFloat(actual, 64)
+			expectedNumber, err2 := strconv.ParseFloat(expected, 64)
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			if operator == ">" {
+				return actualNumber > expectedNumber
+			}
+
+			return actualNumber < expectedNumber
+		}
+	}
+
+	return actual == operationString
+}
+
+func optionValues(options interface{}, name string) []interface{} {
+	list, _ := options.([]interface{})
+	for _, option := range list {
+		optionMap, _ := option.(map[string]interface{})
+		if optionMap["name"] == name {
+			values, _ := optionMap["value"].([]interface{})
+			return values
+		}
+	}
+
+	return []interface{}{}
+}
+
+func valueString(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	}
+
+	return fmt.Sprint(value)
+}
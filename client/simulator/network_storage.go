@@ -0,0 +1,322 @@
+package client_simulator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID = 222
+
+	TRANSACTION_UPGRADE = "Cloud Instance Upgrade"
+)
+
+// STORAGE_SIZES are the capacities (in GB) of the iSCSI volumes priced in the performance storage package
+var STORAGE_SIZES = []int{20, 40, 80, 100, 250, 500, 1000, 2000}
+
+func init() {
+	route("SoftLayer_Network_Storage", "getObject", (*Simulator).getNetworkStorage)
+	route("SoftLayer_Network_Storage", "deleteObject", (*Simulator).deleteNetworkStorage)
+	route("SoftLayer_Network_Storage", "getBillingItem", (*Simulator).getNetworkStorageBillingItem)
+	route("SoftLayer_Network_Storage", "getAllowedVirtualGuests", (*Simulator).getNetworkStorageAllowedVirtualGuests)
+	route("SoftLayer_Network_Storage", "allowAccessFromVirtualGuest", (*Simulator).allowNetworkStorageAccessFromVirtualGuest)
+	route("SoftLayer_Network_Storage", "removeAccessFromVirtualGuest", (*Simulator).removeNetworkStorageAccessFromVirtualGuest)
+
+	route("SoftLayer_Billing_Item", "cancelService", (*Simulator).cancelBillingItem)
+
+	route("SoftLayer_Product_Package", "getItemPrices", (*Simulator).getProductPackageItemPrices)
+	route("SoftLayer_Product_Order", "placeOrder", (*Simulator).placeOrder)
+
+	route("SoftLayer_Account", "getNetworkStorage", (*Simulator).getAccountNetworkStorage)
+	route("SoftLayer_Account", "getIscsiNetworkStorage", (*Simulator).getAccountNetworkStorage)
+}
+
+// Private types
+
+type networkStorage struct {
+	storage datatypes.SoftLayer_Network_Storage
+
+	allowedVirtualGuestIds map[int]bool
+}
+
+// Private methods
+
+func (s *Simulator) findNetworkStorage(r *request) (*networkStorage, error) {
+	storage, ok := s.networkStorages[r.id]
+	if !ok {
+		return nil, notFound(r)
+	}
+
+	return storage, nil
+}
+
+func (s *Simulator) newNetworkStorage(capacityGb int, orderId int) *networkStorage {
+	id := s.newId()
+
+	storage := &networkStorage{
+		storage: datatypes.SoftLayer_Network_Storage{
+			AccountId:                       FIRST_ID,
+			CapacityGb:                      capacityGb,
+			CreateDate:                      time.Now(),
+			Id:                              id,
+			NasType:                         "ISCSI",
+			Username:                        fmt.Sprintf("SL01SL%d-1", id),
+			Password:                        fmt.Sprintf("password%d", id),
+			ServiceProviderId:               1,
+			LunId:                           "0",
+			ServiceResourceBackendIpAddress: fmt.Sprintf("10.1.%d.%d", id/256%256, id%256),
+			BillingItem: &datatypes.Billing_Item{
+				Id:        s.newId(),
+				OrderItem: &datatypes.Order_Item{Order: &datatypes.Order{Id: orderId}},
+			},
+		},
+		allowedVirtualGuestIds: map[int]bool{},
+	}
+
+	s.networkStorages[id] = storage
+
+	return storage
+}
+
+func (s *Simulator) getNetworkStorage(r *request) (interface{}, error) {
+	storage, err := s.findNetworkStorage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMap(storage.storage), nil
+}
+
+func (s *Simulator) deleteNetworkStorage(r *request) (interface{}, error) {
+	storage, err := s.findNetworkStorage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.networkStorages, storage.storage.Id)
+
+	return true, nil
+}
+
+func (s *Simulator) getNetworkStorageBillingItem(r *request) (interface{}, error) {
+	storage, err := s.findNetworkStorage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMap(storage.storage.BillingItem), nil
+}
+
+func (s *Simulator) getNetworkStorageAllowedVirtualGuests(r *request) (interface{}, error) {
+	storage, err := s.findNetworkStorage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{}
+	for id := range storage.allowedVirtualGuestIds {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	virtualGuests := []interface{}{}
+	for _, id := range ids {
+		if guest, ok := s.virtualGuests[id]; ok {
+			virtualGuests = append(virtualGuests, guest.toMap())
+		}
+	}
+
+	return virtualGuests, nil
+}
+
+func (s *Simulator) allowNetworkStorageAccessFromVirtualGuest(r *request) (interface{}, error) {
+	storage, guest, err := s.findNetworkStorageAndVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	storage.allowedVirtualGuestIds[guest.guest.Id] = true
+
+	return true, nil
+}
+
+func (s *Simulator) removeNetworkStorageAccessFromVirtualGuest(r *request) (interface{}, error) {
+	storage, guest, err := s.findNetworkStorageAndVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(storage.allowedVirtualGuestIds, guest.guest.Id)
+
+	return true, nil
+}
+
+func (s *Simulator) findNetworkStorageAndVirtualGuest(r *request) (*networkStorage, *virtualGuest, error) {
+	storage, err := s.findNetworkStorage(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := datatypes.SoftLayer_Virtual_Guest{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, nil, err
+	}
+
+	guest, ok := s.virtualGuests[template.Id]
+	if !ok {
+		return nil, nil, notFound(&request{id: template.Id})
+	}
+
+	return storage, guest, nil
+}
+
+// cancelBillingItem removes the iSCSI volume or reclaims the virtual guest billed by the item
+func (s *Simulator) cancelBillingItem(r *request) (interface{}, error) {
+	for id, storage := range s.networkStorages {
+		if storage.storage.BillingItem.Id == r.id {
+			delete(s.networkStorages, id)
+			return true, nil
+		}
+	}
+
+	for _, guest := range s.virtualGuests {
+		if guest.billingItemId == r.id {
+			s.deleteVirtualGuestLater(guest)
+			return true, nil
+		}
+	}
+
+	return nil, notFound(r)
+}
+
+func (s *Simulator) getProductPackageItemPrices(r *request) (interface{}, error) {
+	if r.id != NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID {
+		return []interface{}{}, nil
+	}
+
+	itemPrices := []interface{}{}
+	for _, size := range STORAGE_SIZES {
+		itemPrices = append(itemPrices, storageItemPrice(size))
+	}
+
+	return itemPrices, nil
+}
+
+func (s *Simulator) placeOrder(r *request) (interface{}, error) {
+	order := datatypes.SoftLayer_Container_Product_Order{}
+	if err := r.parameter(0, &order); err != nil {
+		return nil, err
+	}
+
+	orderId := s.newId()
+
+	switch {
+	case strings.Contains(order.ComplexType, "PerformanceStorage") || strings.Contains(order.ComplexType, "Network_Storage"):
+		capacityGb := 0
+		for _, price := range order.Prices {
+			if size, ok := storageSize(price.Id); ok {
+				capacityGb = size
+			}
+		}
+
+		if capacityGb == 0 {
+			return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_Order_InvalidPrice", "The order has no storage space price.")
+		}
+
+		quantity := order.Quantity
+		if quantity <= 0 {
+			quantity = 1
+		}
+
+		billingOrder := s.newBillingOrder(orderId, ORDER_STATUS_COMPLETE)
+		for i := 0; i < quantity; i++ {
+			storage := s.newNetworkStorage(capacityGb, orderId)
+			s.addBillingOrderItem(billingOrder, "performance_storage_iscsi", storage.storage.BillingItem.Id, storage.storage.Id)
+		}
+	case strings.HasSuffix(order.ComplexType, "Virtual_Guest_Upgrade"):
+		guests := []*virtualGuest{}
+		for _, virtualGuest := range order.VirtualGuests {
+			guest, ok := s.virtualGuests[virtualGuest.Id]
+			if !ok {
+				return nil, notFound(&request{id: virtualGuest.Id})
+			}
+
+			guests = append(guests, guest)
+		}
+
+		//The order completes with the upgrade transaction of its last virtual guest
+		billingOrder := s.newBillingOrder(orderId, ORDER_STATUS_APPROVED)
+		pendingUpgrades := len(guests)
+		for _, guest := range guests {
+			powerState := guest.powerState
+			s.enqueueTransaction(guest, TRANSACTION_UPGRADE, func() {
+				guest.powerState = powerState
+
+				pendingUpgrades--
+				if pendingUpgrades == 0 {
+					billingOrder.Status = ORDER_STATUS_COMPLETE
+				}
+			})
+		}
+	default:
+		return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_Public", fmt.Sprintf("The simulator cannot place orders of type '%s'.", order.ComplexType))
+	}
+
+	return map[string]interface{}{
+		"orderId":     orderId,
+		"orderDate":   time.Now(),
+		"placedOrder": map[string]interface{}{"id": orderId, "status": "APPROVED"},
+	}, nil
+}
+
+func (s *Simulator) getAccountNetworkStorage(r *request) (interface{}, error) {
+	ids := []int{}
+	for id := range s.networkStorages {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	storages := []interface{}{}
+	for _, id := range ids {
+		storages = append(storages, toMap(s.networkStorages[id].storage))
+	}
+
+	return storages, nil
+}
+
+// Private functions
+
+// storageItemPrice is the price of the storage space of an iSCSI volume, with an id derived from its size
+func storageItemPrice(size int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              storagePriceId(size),
+		"locationGroupId": 0,
+		"item": map[string]interface{}{
+			"id":          storagePriceId(size) + 1,
+			"keyName":     fmt.Sprintf("%d_GB_PERFORMANCE_STORAGE_SPACE", size),
+			"description": fmt.Sprintf("%d GB Performance Storage Space", size),
+			"capacity":    strconv.Itoa(size),
+			"units":       "GB",
+		},
+	}
+}
+
+func storagePriceId(size int) int {
+	return 900000 + size*10
+}
+
+func storageSize(priceId int) (int, bool) {
+	for _, size := range STORAGE_SIZES {
+		if storagePriceId(size) == priceId {
+			return size, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,147 @@
+package client_simulator
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+func init() {
+	route("SoftLayer_Security_Ssh_Key", "createObject", (*Simulator).createSshKey)
+	route("SoftLayer_Security_Ssh_Key", "getObject", (*Simulator).getSshKey)
+	route("SoftLayer_Security_Ssh_Key", "editObject", (*Simulator).editSshKey)
+	route("SoftLayer_Security_Ssh_Key", "deleteObject", (*Simulator).deleteSshKey)
+	route("SoftLayer_Security_Ssh_Key", "getSoftwarePasswords", (*Simulator).getSshKeySoftwarePasswords)
+
+	route("SoftLayer_Account", "getSshKeys", (*Simulator).getAccountSshKeys)
+}
+
+// Private types
+
+type sshKey struct {
+	sshKey datatypes.SoftLayer_Security_Ssh_Key
+}
+
+// Private methods
+
+func (s *Simulator) findSshKey(r *request) (*sshKey, error) {
+	key, ok := s.sshKeys[r.id]
+	if !ok {
+		return nil, notFound(r)
+	}
+
+	return key, nil
+}
+
+func (s *Simulator) createSshKey(r *request) (interface{}, error) {
+	template := datatypes.SoftLayer_Security_Ssh_Key{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, err
+	}
+
+	if template.Key == "" {
+		return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_MissingCreationProperty", "Key is required.")
+	}
+
+	now := time.Now()
+	template.Id = s.newId()
+	template.CreateDate = &now
+	template.ModifyDate = nil
+	template.Fingerprint = fingerprint(template.Key)
+
+	s.sshKeys[template.Id] = &sshKey{sshKey: template}
+
+	return toMap(template), nil
+}
+
+func (s *Simulator) getSshKey(r *request) (interface{}, error) {
+	key, err := s.findSshKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMap(key.sshKey), nil
+}
+
+func (s *Simulator) editSshKey(r *request) (interface{}, error) {
+	key, err := s.findSshKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	template := datatypes.SoftLayer_Security_Ssh_Key{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, err
+	}
+
+	if template.Label != "" {
+		key.sshKey.Label = template.Label
+	}
+	if template.Notes != "" {
+		key.sshKey.Notes = template.Notes
+	}
+
+	now := time.Now()
+	key.sshKey.ModifyDate = &now
+
+	return true, nil
+}
+
+func (s *Simulator) deleteSshKey(r *request) (interface{}, error) {
+	key, err := s.findSshKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.sshKeys, key.sshKey.Id)
+
+	return true, nil
+}
+
+func (s *Simulator) getSshKeySoftwarePasswords(r *request) (interface{}, error) {
+	if _, err := s.findSshKey(r); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{}, nil
+}
+
+func (s *Simulator) getAccountSshKeys(r *request) (interface{}, error) {
+	ids := []int{}
+	for id := range s.sshKeys {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	keys := []interface{}{}
+	for _, id := range ids {
+		keys = append(keys, toMap(s.sshKeys[id].sshKey))
+	}
+
+	return keys, nil
+}
+
+// Private functions
+
+// fingerprint is the MD5 fingerprint of an OpenSSH public key, e.g. "43:51:43:a1:b5:fc:8b:b7:0a:3a:a9:b1:0f:66:73:a8"
+func fingerprint(key string) string {
+	data := []byte(key)
+	if fields := strings.Fields(key); len(fields) > 1 {
+		if decoded, err := base64.StdEncoding.DecodeString(fields[1]); err == nil {
+			data = decoded
+		}
+	}
+
+	hexBytes := []string{}
+	for _, b := range md5.Sum(data) {
+		hexBytes = append(hexBytes, fmt.Sprintf("%02x", b))
+	}
+
+	return strings.Join(hexBytes, ":")
+}
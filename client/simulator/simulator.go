@@ -0,0 +1,319 @@
+package client_simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	API_PATH = "/rest/v3"
+
+	DEFAULT_TRANSACTION_DURATION = 1 * time.Second
+
+	FIRST_ID = 1000
+)
+
+// Simulator is an in-process SoftLayer REST API keeping the state of virtual guests (with their provisioning,
+// power and reclaim transactions), DNS domains and records, SSH keys, iSCSI volumes and the orders creating them.
+// Object masks are ignored, object filters and result limits are honored. Point a client at it with e.g.
+//
+//	simulator := client_simulator.NewSimulator()
+//	defer simulator.Close()
+//
+//	httpClient := slclient.NewHttpClient("username", "api-key", simulator.ApiUrl(), "templates", false)
+type Simulator struct {
+	Server *httptest.Server
+
+	//Time each transaction of a virtual guest takes, e.g. provisioning or powering off
+	TransactionDuration time.Duration
+
+	nextId int
+
+	virtualGuests   map[int]*virtualGuest
+	dnsDomains      map[int]*dnsDomain
+	resourceRecords map[int]*resourceRecord
+	sshKeys         map[int]*sshKey
+	networkStorages map[int]*networkStorage
+	billingOrders   map[int]*datatypes.SoftLayer_Billing_Order
+
+	mutex sync.Mutex
+}
+
+func NewSimulator() *Simulator {
+	simulator := &Simulator{
+		TransactionDuration: DEFAULT_TRANSACTION_DURATION,
+
+		nextId: FIRST_ID,
+
+		virtualGuests:   map[int]*virtualGuest{},
+		dnsDomains:      map[int]*dnsDomain{},
+		resourceRecords: map[int]*resourceRecord{},
+		sshKeys:         map[int]*sshKey{},
+		networkStorages: map[int]*networkStorage{},
+		billingOrders:   map[int]*datatypes.SoftLayer_Billing_Order{},
+	}
+
+	simulator.Server = httptest.NewServer(simulator)
+
+	return simulator
+}
+
+func (s *Simulator) Close() {
+	s.Server.Close()
+}
+
+// ApiUrl is the endpoint of the simulator without its scheme, as expected by slclient.NewHttpClient with useHttps=false
+func (s *Simulator) ApiUrl() string {
+	return strings.TrimPrefix(s.Server.URL, "http://") + API_PATH
+}
+
+// NewSoftLayerClient returns a client of the simulator, logging nothing
+func (s *Simulator) NewSoftLayerClient(options ...slclient.Option) *slclient.SoftLayerClient {
+	options = append([]slclient.Option{
+		slclient.WithBaseUrl(s.Server.URL + API_PATH),
+		slclient.WithLogger(slclient.NewNopLogger()),
+	}, options...)
+
+	return slclient.NewSoftLayerClient("simulator", "simulator", options...)
+}
+
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := newRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	route, ok := routes[request.service+"#"+request.method]
+	if !ok {
+		writeError(w, newError(http.StatusNotFound, common.SOFTLAYER_EXCEPTION_NOT_FOUND, fmt.Sprintf("Function (\"%s\") is not a valid method for this service.", request.method)))
+		return
+	}
+
+	s.mutex.Lock()
+	s.advanceTransactions(time.Now())
+	result, err := route(s, request)
+	s.mutex.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if list, ok := result.([]interface{}); ok {
+		list = request.filterList(list)
+		w.Header().Set(slclient.SOFTLAYER_TOTAL_ITEMS_HEADER, strconv.Itoa(len(list)))
+		result = request.limitList(list)
+	}
+
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		writeError(w, newError(http.StatusInternalServerError, "SoftLayer_Exception", err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(bytes)
+}
+
+// Private types
+
+type routeHandler func(s *Simulator, r *request) (interface{}, error)
+
+type request struct {
+	service    string
+	id         int
+	method     string
+	parameters []json.RawMessage
+
+	objectFilter map[string]interface{}
+	offset       int
+	limit        int
+}
+
+type simulatorError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e *simulatorError) Error() string {
+	return e.message
+}
+
+// Private methods
+
+func (s *Simulator) newId() int {
+	s.nextId++
+	return s.nextId
+}
+
+func (r *request) parameter(index int, value interface{}) error {
+	if index >= len(r.parameters) {
+		return newError(http.StatusBadRequest, "SoftLayer_Exception_Public", fmt.Sprintf("%s::%s expects at least %d parameters", r.service, r.method, index+1))
+	}
+
+	if err := json.Unmarshal(r.parameters[index], value); err != nil {
+		return newError(http.StatusBadRequest, "SoftLayer_Exception_Public", fmt.Sprintf("Invalid parameter %d of %s::%s: %s", index, r.service, r.method, err.Error()))
+	}
+
+	return nil
+}
+
+// filterList applies the object filter of the request, e.g. {"virtualGuests":{"hostname":{"operation":"foo"}}}, to the items of a list
+func (r *request) filterList(list []interface{}) []interface{} {
+	if len(r.objectFilter) == 0 {
+		return list
+	}
+
+	filtered := []interface{}{}
+	for _, item := range list {
+		matched := true
+		for _, propertyFilter := range r.objectFilter {
+			if subFilter, ok := propertyFilter.(map[string]interface{}); ok && !matchesFilter(item, subFilter) {
+				matched = false
+			}
+		}
+
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+func (r *request) limitList(list []interface{}) []interface{} {
+	if r.limit <= 0 {
+		return list
+	}
+
+	if r.offset >= len(list) {
+		return []interface{}{}
+	}
+
+	end := r.offset + r.limit
+	if end > len(list) {
+		end = len(list)
+	}
+
+	return list[r.offset:end]
+}
+
+// Private functions
+
+var routes = map[string]routeHandler{}
+
+func route(service string, method string, handler routeHandler) {
+	routes[service+"#"+method] = handler
+}
+
+func newRequest(r *http.Request) (*request, error) {
+	path := strings.TrimSuffix(r.URL.Path, ".json")
+	if index := strings.Index(path, "/SoftLayer_"); index >= 0 {
+		path = path[index+1:]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	request := &request{service: segments[0]}
+
+	for _, segment := range segments[1:] {
+		if id, err := strconv.Atoi(segment); err == nil && request.method == "" {
+			request.id = id
+			continue
+		}
+
+		request.method = segment
+	}
+
+	if request.method == "" {
+		request.method = map[string]string{"POST": "createObject", "PUT": "editObject", "DELETE": "deleteObject"}[r.Method]
+		if request.method == "" {
+			request.method = "getObject"
+		}
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(strings.TrimSpace(string(body))) > 0 {
+		parameters := struct {
+			Parameters []json.RawMessage `json:"parameters"`
+		}{}
+		if err := json.Unmarshal(body, &parameters); err != nil {
+			return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_Public", "Invalid JSON body: "+err.Error())
+		}
+
+		request.parameters = parameters.Parameters
+	}
+
+	if objectFilter := r.URL.Query().Get("objectFilter"); objectFilter != "" {
+		if err := json.Unmarshal([]byte(objectFilter), &request.objectFilter); err != nil {
+			return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_Public", "Invalid object filter: "+err.Error())
+		}
+	}
+
+	if resultLimit := r.URL.Query().Get("resultLimit"); resultLimit != "" {
+		if _, err := fmt.Sscanf(resultLimit, "%d,%d", &request.offset, &request.limit); err != nil {
+			return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_Public", "Invalid result limit: "+resultLimit)
+		}
+	}
+
+	return request, nil
+}
+
+func newError(statusCode int, code string, message string) error {
+	return &simulatorError{statusCode: statusCode, code: code, message: message}
+}
+
+func notFound(r *request) error {
+	return newError(http.StatusNotFound, common.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND, fmt.Sprintf("Unable to find object with id of '%d'.", r.id))
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	slError, ok := err.(*simulatorError)
+	if !ok {
+		slError = &simulatorError{statusCode: http.StatusInternalServerError, code: "SoftLayer_Exception", message: err.Error()}
+	}
+
+	bytes, _ := json.Marshal(map[string]string{"error": slError.message, "code": slError.code})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(slError.statusCode)
+	w.Write(bytes)
+}
+
+// toMap converts a data type to its generic JSON form, to add fields or apply object filters
+func toMap(value interface{}) map[string]interface{} {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{}
+	json.Unmarshal(bytes, &result)
+
+	return result
+}
+
+func billingItem(billingItemId int, orderId int) map[string]interface{} {
+	item := map[string]interface{}{"id": billingItemId}
+	if orderId != 0 {
+		item["orderItem"] = map[string]interface{}{"order": map[string]interface{}{"id": orderId}}
+	}
+
+	return item
+}
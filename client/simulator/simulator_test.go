@@ -0,0 +1,291 @@
+package client_simulator_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	simulator "github.com/maximilien/softlayer-go/client/simulator"
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	filter "github.com/maximilien/softlayer-go/filter"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Simulator", func() {
+	var (
+		sim    *simulator.Simulator
+		client *slclient.SoftLayerClient
+
+		virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service
+		accountService      softlayer.SoftLayer_Account_Service
+	)
+
+	BeforeEach(func() {
+		sim = simulator.NewSimulator()
+		sim.TransactionDuration = 20 * time.Millisecond
+
+		client = sim.NewSoftLayerClient()
+
+		var err error
+		virtualGuestService, err = client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		accountService, err = client.GetSoftLayer_Account_Service()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		sim.Close()
+	})
+
+	createVirtualGuest := func(hostname string) datatypes.SoftLayer_Virtual_Guest {
+		virtualGuest, err := virtualGuestService.CreateObject(datatypes.SoftLayer_Virtual_Guest_Template{
+			Hostname:   hostname,
+			Domain:     "softlayer.com",
+			StartCpus:  1,
+			MaxMemory:  1024,
+			Datacenter: datatypes.Datacenter{Name: "ams01"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		return virtualGuest
+	}
+
+	powerStateOf := func(virtualGuestId int) func() string {
+		return func() string {
+			powerState, err := virtualGuestService.GetPowerState(virtualGuestId)
+			Expect(err).ToNot(HaveOccurred())
+
+			return powerState.KeyName
+		}
+	}
+
+	It("is reachable with an HttpClient built from its API URL", func() {
+		httpClient := slclient.NewHttpClient("fake-username", "fake-api-key", sim.ApiUrl(), "templates", false)
+		httpClient.Logger = slclient.NewNopLogger()
+
+		response, errorCode, err := httpClient.DoRawHttpRequest("SoftLayer_Account/getVirtualGuests.json", "GET", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(errorCode).To(Equal(200))
+		Expect(string(response)).To(Equal("[]"))
+	})
+
+	Context("virtual guests", func() {
+		It("provisions a virtual guest through its transactions", func() {
+			virtualGuest := createVirtualGuest("simulated")
+			Expect(virtualGuest.Id).ToNot(BeZero())
+			Expect(virtualGuest.FullyQualifiedDomainName).To(Equal("simulated.softlayer.com"))
+			Expect(virtualGuest.PrimaryBackendIpAddress).ToNot(BeEmpty())
+
+			Expect(powerStateOf(virtualGuest.Id)()).To(Equal("HALTED"))
+
+			activeTransactions, err := virtualGuestService.GetActiveTransactions(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(activeTransactions).To(HaveLen(1))
+			Expect(activeTransactions[0].TransactionGroup.Name).To(Equal(simulator.TRANSACTION_PROVISION))
+
+			Eventually(powerStateOf(virtualGuest.Id)).Should(Equal("RUNNING"))
+
+			activeTransactions, err = virtualGuestService.GetActiveTransactions(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(activeTransactions).To(BeEmpty())
+
+			lastTransaction, err := virtualGuestService.GetLastTransaction(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastTransaction.TransactionStatus.Name).To(Equal("COMPLETE"))
+		})
+
+		It("rejects a template missing required properties", func() {
+			_, err := virtualGuestService.CreateObject(datatypes.SoftLayer_Virtual_Guest_Template{Hostname: "simulated"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("powers off and on a virtual guest", func() {
+			virtualGuest := createVirtualGuest("simulated")
+			Eventually(powerStateOf(virtualGuest.Id)).Should(Equal("RUNNING"))
+
+			powerOff, err := virtualGuestService.PowerOff(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerOff).To(BeTrue())
+			Eventually(powerStateOf(virtualGuest.Id)).Should(Equal("HALTED"))
+
+			powerOn, err := virtualGuestService.PowerOn(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerOn).To(BeTrue())
+			Eventually(powerStateOf(virtualGuest.Id)).Should(Equal("RUNNING"))
+		})
+
+		It("edits and tags a virtual guest", func() {
+			virtualGuest := createVirtualGuest("simulated")
+
+			edited, err := virtualGuestService.EditObject(virtualGuest.Id, datatypes.SoftLayer_Virtual_Guest{Hostname: "edited"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			tagged, err := virtualGuestService.SetTags(virtualGuest.Id, []string{"tag1", "tag2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tagged).To(BeTrue())
+
+			virtualGuest, err = virtualGuestService.GetObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.FullyQualifiedDomainName).To(Equal("edited.softlayer.com"))
+
+			tagReferences, err := virtualGuestService.GetTagReferences(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tagReferences).To(HaveLen(2))
+			Expect(tagReferences[1].Tag.Name).To(Equal("tag2"))
+		})
+
+		It("reclaims a deleted virtual guest", func() {
+			virtualGuest := createVirtualGuest("simulated")
+
+			deleted, err := virtualGuestService.DeleteObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			Eventually(func() bool {
+				_, err := virtualGuestService.GetObject(virtualGuest.Id)
+				return common.IsNotFound(err)
+			}).Should(BeTrue())
+
+			virtualGuests, err := accountService.GetVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).To(BeEmpty())
+		})
+
+		It("answers not found for unknown virtual guests", func() {
+			_, err := virtualGuestService.GetObject(1)
+			Expect(err).To(HaveOccurred())
+			Expect(common.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("object filters and result limits", func() {
+		BeforeEach(func() {
+			for _, hostname := range []string{"web-1", "web-2", "db-1"} {
+				createVirtualGuest(hostname)
+			}
+		})
+
+		It("filters the listed objects", func() {
+			virtualGuests, err := accountService.GetVirtualGuestsByFilter(filter.Path("virtualGuests.hostname").StartsWith("web").Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).To(HaveLen(2))
+			Expect(virtualGuests[0].Hostname).To(Equal("web-1"))
+			Expect(virtualGuests[1].Hostname).To(Equal("web-2"))
+		})
+
+		It("pages the listed objects with their total count", func() {
+			request := softlayer.NewRequest("SoftLayer_Account", "getVirtualGuests").WithResultLimit(2).WithOffset(2)
+
+			response, errorCode, totalItems, err := client.GetHttpClient().DoPagedRequest(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorCode).To(Equal(200))
+			Expect(totalItems).To(Equal(3))
+			Expect(string(response)).To(ContainSubstring(`"hostname":"db-1"`))
+			Expect(string(response)).ToNot(ContainSubstring(`"hostname":"web-1"`))
+		})
+	})
+
+	Context("DNS domains", func() {
+		It("creates, edits and deletes a domain with its records", func() {
+			dnsDomainService, err := client.GetSoftLayer_Dns_Domain_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			resourceRecordService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			domain, err := dnsDomainService.CreateObject(datatypes.SoftLayer_Dns_Domain_Template{Name: "example.com"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domain.Id).ToNot(BeZero())
+
+			record, err := resourceRecordService.CreateObject(datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{
+				DomainId: domain.Id,
+				Host:     "www",
+				Data:     "127.0.0.1",
+				Type:     "a",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			edited, err := resourceRecordService.EditObject(record.Id, datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "www", Data: "127.0.0.2", Type: "a"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			domain, err = dnsDomainService.GetObject(domain.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domain.ResourceRecords).To(HaveLen(1))
+			Expect(domain.ResourceRecords[0].Data).To(Equal("127.0.0.2"))
+
+			deleted, err := dnsDomainService.DeleteObject(domain.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			_, err = resourceRecordService.GetObject(record.Id)
+			Expect(common.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("SSH keys", func() {
+		It("creates, edits and deletes a key", func() {
+			sshKeyService, err := client.GetSoftLayer_Security_Ssh_Key_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			sshKey, err := sshKeyService.CreateObject(datatypes.SoftLayer_Security_Ssh_Key{Key: "ssh-rsa AAAAB3NzaC1yc2E= test", Label: "test"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sshKey.Fingerprint).To(MatchRegexp(`^([0-9a-f]{2}:){15}[0-9a-f]{2}$`))
+
+			edited, err := sshKeyService.EditObject(sshKey.Id, datatypes.SoftLayer_Security_Ssh_Key{Label: "edited"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			sshKey, err = sshKeyService.GetObject(sshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sshKey.Label).To(Equal("edited"))
+
+			deleted, err := sshKeyService.DeleteObject(sshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			sshKeys, err := accountService.GetSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sshKeys).To(BeEmpty())
+		})
+	})
+
+	Context("iSCSI volumes", func() {
+		It("orders, attaches and cancels a volume", func() {
+			networkStorageService, err := client.GetSoftLayer_Network_Storage_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			volume, err := networkStorageService.CreateIscsiVolume(20, "ams01")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volume.CapacityGb).To(Equal(20))
+			Expect(volume.BillingItem.OrderItem.Order.Id).ToNot(BeZero())
+
+			virtualGuest := createVirtualGuest("simulated")
+
+			attached, err := networkStorageService.AttachIscsiVolume(virtualGuest, volume.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attached).To(BeTrue())
+
+			allowed, err := networkStorageService.HasAllowedVirtualGuest(volume.Id, virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+
+			Expect(networkStorageService.DetachIscsiVolume(virtualGuest, volume.Id)).To(Succeed())
+
+			allowed, err = networkStorageService.HasAllowedVirtualGuest(volume.Id, virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+
+			Expect(networkStorageService.DeleteIscsiVolume(volume.Id, true)).To(Succeed())
+
+			volumes, err := accountService.GetIscsiNetworkStorage()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volumes).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,488 @@
+package client_simulator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	TRANSACTION_PROVISION = "Cloud Instance Provisioning"
+	TRANSACTION_POWER_ON  = "Power On"
+	TRANSACTION_POWER_OFF = "Power Off"
+	TRANSACTION_REBOOT    = "Reboot"
+	TRANSACTION_OS_RELOAD = "Operating System Reload"
+	TRANSACTION_RECLAIM   = "Cloud Reclaim"
+
+	POWER_STATE_RUNNING = "RUNNING"
+	POWER_STATE_HALTED  = "HALTED"
+)
+
+func init() {
+	route("SoftLayer_Virtual_Guest", "createObject", (*Simulator).createVirtualGuest)
+	route("SoftLayer_Virtual_Guest", "getObject", (*Simulator).getVirtualGuest)
+	route("SoftLayer_Virtual_Guest", "editObject", (*Simulator).editVirtualGuest)
+	route("SoftLayer_Virtual_Guest", "deleteObject", (*Simulator).deleteVirtualGuest)
+	route("SoftLayer_Virtual_Guest", "getPowerState", (*Simulator).getVirtualGuestPowerState)
+	route("SoftLayer_Virtual_Guest", "powerOn", virtualGuestPowerAction(TRANSACTION_POWER_ON, POWER_STATE_RUNNING))
+	route("SoftLayer_Virtual_Guest", "powerOff", virtualGuestPowerAction(TRANSACTION_POWER_OFF, POWER_STATE_HALTED))
+	route("SoftLayer_Virtual_Guest", "powerOffSoft", virtualGuestPowerAction(TRANSACTION_POWER_OFF, POWER_STATE_HALTED))
+	route("SoftLayer_Virtual_Guest", "powerCycle", virtualGuestPowerAction(TRANSACTION_REBOOT, POWER_STATE_RUNNING))
+	route("SoftLayer_Virtual_Guest", "rebootDefault", virtualGuestPowerAction(TRANSACTION_REBOOT, POWER_STATE_RUNNING))
+	route("SoftLayer_Virtual_Guest", "rebootSoft", virtualGuestPowerAction(TRANSACTION_REBOOT, POWER_STATE_RUNNING))
+	route("SoftLayer_Virtual_Guest", "rebootHard", virtualGuestPowerAction(TRANSACTION_REBOOT, POWER_STATE_RUNNING))
+	route("SoftLayer_Virtual_Guest", "reloadOperatingSystem", (*Simulator).reloadVirtualGuestOperatingSystem)
+	route("SoftLayer_Virtual_Guest", "getActiveTransaction", (*Simulator).getVirtualGuestActiveTransaction)
+	route("SoftLayer_Virtual_Guest", "getActiveTransactions", (*Simulator).getVirtualGuestActiveTransactions)
+	route("SoftLayer_Virtual_Guest", "getLastTransaction", (*Simulator).getVirtualGuestLastTransaction)
+	route("SoftLayer_Virtual_Guest", "getPrimaryIpAddress", (*Simulator).getVirtualGuestPrimaryIpAddress)
+	route("SoftLayer_Virtual_Guest", "getPrimaryBackendIpAddress", (*Simulator).getVirtualGuestPrimaryBackendIpAddress)
+	route("SoftLayer_Virtual_Guest", "isPingable", (*Simulator).isVirtualGuestPingable)
+	route("SoftLayer_Virtual_Guest", "isBackendPingable", (*Simulator).isVirtualGuestPingable)
+	route("SoftLayer_Virtual_Guest", "setTags", (*Simulator).setVirtualGuestTags)
+	route("SoftLayer_Virtual_Guest", "getTagReferences", (*Simulator).getVirtualGuestTagReferences)
+	route("SoftLayer_Virtual_Guest", "getSshKeys", (*Simulator).getVirtualGuestSshKeys)
+	route("SoftLayer_Virtual_Guest", "getUserData", (*Simulator).getVirtualGuestUserData)
+	route("SoftLayer_Virtual_Guest", "getBillingItem", (*Simulator).getVirtualGuestBillingItem)
+
+	route("SoftLayer_Account", "getVirtualGuests", (*Simulator).getAccountVirtualGuests)
+}
+
+// Private types
+
+type virtualGuest struct {
+	guest      datatypes.SoftLayer_Virtual_Guest
+	powerState string
+	tags       []string
+	sshKeyIds  []int
+
+	billingItemId int
+	orderId       int
+
+	transactions    []*transaction
+	lastTransaction *transaction
+}
+
+type transaction struct {
+	id         int
+	name       string
+	createDate time.Time
+	startDate  time.Time
+
+	apply func()
+}
+
+// Private methods
+
+func (s *Simulator) newVirtualGuest(template datatypes.SoftLayer_Virtual_Guest_Template, orderId int) *virtualGuest {
+	id := s.newId()
+	now := time.Now()
+
+	guest := &virtualGuest{
+		guest: datatypes.SoftLayer_Virtual_Guest{
+			Id:                           id,
+			AccountId:                    FIRST_ID,
+			CreateDate:                   &now,
+			Hostname:                     template.Hostname,
+			Domain:                       template.Domain,
+			FullyQualifiedDomainName:     template.Hostname + "." + template.Domain,
+			StartCpus:                    template.StartCpus,
+			MaxCpu:                       template.StartCpus,
+			MaxCpuUnits:                  "CORE",
+			MaxMemory:                    template.MaxMemory,
+			HourlyBillingFlag:            template.HourlyBillingFlag,
+			LocalDiskFlag:                template.LocalDiskFlag,
+			PrivateNetworkOnlyFlag:       template.PrivateNetworkOnlyFlag,
+			PostInstallScriptUri:         template.PostInstallScriptUri,
+			DedicatedAccountHostOnlyFlag: template.DedicatedAccountHostOnlyFlag,
+			UserData:                     template.UserData,
+			GlobalIdentifier:             fmt.Sprintf("%08x-0000-4000-8000-%012x", id, id),
+			Uuid:                         fmt.Sprintf("%08x-0000-4000-9000-%012x", id, id),
+			PrimaryIpAddress:             fmt.Sprintf("169.%d.%d.%d", 50+id/65536%100, id/256%256, id%256),
+			PrimaryBackendIpAddress:      fmt.Sprintf("10.%d.%d.%d", id/65536%256, id/256%256, id%256),
+			Location:                     &datatypes.SoftLayer_Location{Name: template.Datacenter.Name},
+			Datacenter:                   &datatypes.SoftLayer_Location{Name: template.Datacenter.Name},
+			OperatingSystem: &datatypes.SoftLayer_Operating_System{
+				Passwords: []datatypes.SoftLayer_Password{{Username: "root", Password: fmt.Sprintf("password%d", id)}},
+			},
+			BlockDeviceTemplateGroup: template.BlockDeviceTemplateGroup,
+		},
+		powerState:    POWER_STATE_HALTED,
+		billingItemId: s.newId(),
+		orderId:       orderId,
+	}
+
+	for _, sshKey := range template.SshKeys {
+		guest.sshKeyIds = append(guest.sshKeyIds, sshKey.Id)
+	}
+
+	s.virtualGuests[id] = guest
+	s.enqueueTransaction(guest, TRANSACTION_PROVISION, func() {
+		guest.powerState = POWER_STATE_RUNNING
+	})
+
+	return guest
+}
+
+func (s *Simulator) enqueueTransaction(guest *virtualGuest, name string, apply func()) {
+	now := time.Now()
+
+	t := &transaction{
+		id:         s.newId(),
+		name:       name,
+		createDate: now,
+		apply:      apply,
+	}
+
+	if len(guest.transactions) == 0 {
+		t.startDate = now
+	}
+
+	guest.transactions = append(guest.transactions, t)
+}
+
+// advanceTransactions completes the transactions of the virtual guests lasting for more than TransactionDuration,
+// each transaction starting when the previous one completes
+func (s *Simulator) advanceTransactions(now time.Time) {
+	for _, guest := range s.virtualGuests {
+		for len(guest.transactions) > 0 {
+			current := guest.transactions[0]
+			end := current.startDate.Add(s.TransactionDuration)
+			if now.Before(end) {
+				break
+			}
+
+			current.apply()
+			guest.lastTransaction = current
+			guest.transactions = guest.transactions[1:]
+
+			if len(guest.transactions) > 0 {
+				guest.transactions[0].startDate = end
+			}
+		}
+	}
+}
+
+func (s *Simulator) findVirtualGuest(r *request) (*virtualGuest, error) {
+	guest, ok := s.virtualGuests[r.id]
+	if !ok {
+		return nil, notFound(r)
+	}
+
+	return guest, nil
+}
+
+func (s *Simulator) deleteVirtualGuestLater(guest *virtualGuest) {
+	s.enqueueTransaction(guest, TRANSACTION_RECLAIM, func() {
+		delete(s.virtualGuests, guest.guest.Id)
+	})
+}
+
+func (g *virtualGuest) toMap() map[string]interface{} {
+	result := toMap(g.guest)
+	result["powerState"] = powerState(g.powerState)
+	result["billingItem"] = billingItem(g.billingItemId, g.orderId)
+	result["activeTransactionCount"] = len(g.transactions)
+
+	tagReferences := []interface{}{}
+	for _, tag := range g.tags {
+		tagReferences = append(tagReferences, map[string]interface{}{"tag": map[string]interface{}{"name": tag}})
+	}
+	result["tagReferences"] = tagReferences
+
+	return result
+}
+
+func (t *transaction) toMap(guestId int, completed bool) map[string]interface{} {
+	status := "PENDING"
+	if completed {
+		status = "COMPLETE"
+	} else if !t.startDate.IsZero() {
+		status = strings.ToUpper(strings.Replace(t.name, " ", "_", -1))
+	}
+
+	elapsedSeconds := 0
+	if !t.startDate.IsZero() {
+		elapsedSeconds = int(time.Since(t.startDate).Seconds())
+	}
+
+	return toMap(datatypes.SoftLayer_Provisioning_Version1_Transaction{
+		CreateDate:        &t.createDate,
+		ElapsedSeconds:    elapsedSeconds,
+		GuestId:           guestId,
+		Id:                t.id,
+		TransactionGroup:  datatypes.TransactionGroup{Name: t.name},
+		TransactionStatus: datatypes.TransactionStatus{Name: status, FriendlyName: t.name},
+	})
+}
+
+func (s *Simulator) createVirtualGuest(r *request) (interface{}, error) {
+	template := datatypes.SoftLayer_Virtual_Guest_Template{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, err
+	}
+
+	if template.Hostname == "" || template.Domain == "" || template.StartCpus <= 0 || template.MaxMemory <= 0 || template.Datacenter.Name == "" {
+		return nil, newError(http.StatusBadRequest, "SoftLayer_Exception_MissingCreationProperty", "Hostname, domain, startCpus, maxMemory and datacenter.name are required.")
+	}
+
+	return s.newVirtualGuest(template, 0).toMap(), nil
+}
+
+func (s *Simulator) getVirtualGuest(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.toMap(), nil
+}
+
+func (s *Simulator) editVirtualGuest(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	template := datatypes.SoftLayer_Virtual_Guest{}
+	if err := r.parameter(0, &template); err != nil {
+		return nil, err
+	}
+
+	if template.Hostname != "" {
+		guest.guest.Hostname = template.Hostname
+	}
+	if template.Domain != "" {
+		guest.guest.Domain = template.Domain
+	}
+	if template.Notes != "" {
+		guest.guest.Notes = template.Notes
+	}
+	guest.guest.FullyQualifiedDomainName = guest.guest.Hostname + "." + guest.guest.Domain
+
+	now := time.Now()
+	guest.guest.ModifyDate = &now
+
+	return true, nil
+}
+
+func (s *Simulator) deleteVirtualGuest(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.deleteVirtualGuestLater(guest)
+
+	return true, nil
+}
+
+func (s *Simulator) getVirtualGuestPowerState(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return powerState(guest.powerState), nil
+}
+
+func (s *Simulator) reloadVirtualGuestOperatingSystem(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	guest.powerState = POWER_STATE_HALTED
+	s.enqueueTransaction(guest, TRANSACTION_OS_RELOAD, func() {
+		guest.powerState = POWER_STATE_RUNNING
+	})
+
+	return "1", nil
+}
+
+func (s *Simulator) getVirtualGuestActiveTransaction(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(guest.transactions) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	return guest.transactions[0].toMap(guest.guest.Id, false), nil
+}
+
+func (s *Simulator) getVirtualGuestActiveTransactions(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := []interface{}{}
+	for _, t := range guest.transactions {
+		transactions = append(transactions, t.toMap(guest.guest.Id, false))
+	}
+
+	return transactions, nil
+}
+
+func (s *Simulator) getVirtualGuestLastTransaction(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(guest.transactions) > 0 {
+		return guest.transactions[0].toMap(guest.guest.Id, false), nil
+	}
+
+	if guest.lastTransaction == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	return guest.lastTransaction.toMap(guest.guest.Id, true), nil
+}
+
+func (s *Simulator) getVirtualGuestPrimaryIpAddress(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.guest.PrimaryIpAddress, nil
+}
+
+func (s *Simulator) getVirtualGuestPrimaryBackendIpAddress(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.guest.PrimaryBackendIpAddress, nil
+}
+
+func (s *Simulator) isVirtualGuestPingable(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.powerState == POWER_STATE_RUNNING, nil
+}
+
+func (s *Simulator) setVirtualGuestTags(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := ""
+	if err := r.parameter(0, &tags); err != nil {
+		return nil, err
+	}
+
+	guest.tags = []string{}
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			guest.tags = append(guest.tags, tag)
+		}
+	}
+
+	return true, nil
+}
+
+func (s *Simulator) getVirtualGuestTagReferences(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tagReferences, _ := guest.toMap()["tagReferences"].([]interface{})
+	return tagReferences, nil
+}
+
+func (s *Simulator) getVirtualGuestSshKeys(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	sshKeys := []interface{}{}
+	for _, sshKeyId := range guest.sshKeyIds {
+		if key, ok := s.sshKeys[sshKeyId]; ok {
+			sshKeys = append(sshKeys, toMap(key.sshKey))
+		}
+	}
+
+	return sshKeys, nil
+}
+
+func (s *Simulator) getVirtualGuestUserData(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	userData := []interface{}{}
+	for _, data := range guest.guest.UserData {
+		userData = append(userData, toMap(data))
+	}
+
+	return userData, nil
+}
+
+func (s *Simulator) getVirtualGuestBillingItem(r *request) (interface{}, error) {
+	guest, err := s.findVirtualGuest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return billingItem(guest.billingItemId, guest.orderId), nil
+}
+
+func (s *Simulator) getAccountVirtualGuests(r *request) (interface{}, error) {
+	ids := []int{}
+	for id := range s.virtualGuests {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	virtualGuests := []interface{}{}
+	for _, id := range ids {
+		virtualGuests = append(virtualGuests, s.virtualGuests[id].toMap())
+	}
+
+	return virtualGuests, nil
+}
+
+// Private functions
+
+func virtualGuestPowerAction(transactionName string, targetPowerState string) routeHandler {
+	return func(s *Simulator, r *request) (interface{}, error) {
+		guest, err := s.findVirtualGuest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		s.enqueueTransaction(guest, transactionName, func() {
+			guest.powerState = targetPowerState
+		})
+
+		return true, nil
+	}
+}
+
+func powerState(keyName string) map[string]interface{} {
+	name := strings.Title(strings.ToLower(keyName))
+
+	return toMap(datatypes.SoftLayer_Virtual_Guest_Power_State{
+		KeyName:     keyName,
+		Name:        name,
+		Description: name,
+	})
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
@@ -16,12 +18,17 @@ const (
 type SoftLayerClient struct {
 	HttpClient softlayer.HttpClient
 
-	softLayerServices map[string]softlayer.Service
+	softLayerServicesLock sync.RWMutex
+	softLayerServices     map[string]softlayer.Service
 }
 
-func NewSoftLayerClient(username, apiKey string) *SoftLayerClient {
+func NewSoftLayerClient(username, apiKey string, options ...Option) *SoftLayerClient {
+	return NewSoftLayerClientWithAuthenticator(NewBasicAuthenticator(username, apiKey), options...)
+}
+
+func NewSoftLayerClientWithAuthenticator(authenticator Authenticator, options ...Option) *SoftLayerClient {
 	slc := &SoftLayerClient{
-		HttpClient: NewHttpsClient(username, apiKey, SOFTLAYER_API_URL, TEMPLATE_ROOT_PATH),
+		HttpClient: newHttpClient(authenticator, options...),
 
 		softLayerServices: map[string]softlayer.Service{},
 	}
@@ -31,6 +38,41 @@ func NewSoftLayerClient(username, apiKey string) *SoftLayerClient {
 	return slc
 }
 
+// NewSoftLayerClientFromCredentials uses the first credentials found by the providers, by default (nil) the
+// SL_USERNAME and SL_API_KEY environment variables and then the ~/.softlayer config file
+func NewSoftLayerClientFromCredentials(providers []CredentialProvider, options ...Option) (*SoftLayerClient, error) {
+	if len(providers) == 0 {
+		providers = DefaultCredentialProviders()
+	}
+
+	credentials, err := NewChainCredentialProvider(providers...).Retrieve()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftLayerClient(credentials.Username, credentials.ApiKey, options...), nil
+}
+
+// RegisterService makes GetService return the service built by the factory for the name, replacing any service of the
+// same name
+func (slc *SoftLayerClient) RegisterService(name string, factory softlayer.ServiceFactory) {
+	service := factory(slc)
+
+	slc.softLayerServicesLock.Lock()
+	defer slc.softLayerServicesLock.Unlock()
+
+	slc.softLayerServices[name] = service
+}
+
+// Call sends a method softlayer-go does not wrap yet, see softlayer.Call
+func (slc *SoftLayerClient) Call(service string, method string, id int, parameters []interface{}, result interface{}) error {
+	return softlayer.Call(slc, service, method, id, parameters, result)
+}
+
+func (slc *SoftLayerClient) CallWithContext(ctx context.Context, service string, method string, id int, parameters []interface{}, result interface{}) error {
+	return softlayer.CallWithContext(ctx, slc, service, method, id, parameters, result)
+}
+
 //softlayer.Client interface methods
 
 func (slc *SoftLayerClient) GetHttpClient() softlayer.HttpClient {
@@ -38,6 +80,9 @@ func (slc *SoftLayerClient) GetHttpClient() softlayer.HttpClient {
 }
 
 func (slc *SoftLayerClient) GetService(serviceName string) (softlayer.Service, error) {
+	slc.softLayerServicesLock.RLock()
+	defer slc.softLayerServicesLock.RUnlock()
+
 	slService, ok := slc.softLayerServices[serviceName]
 	if !ok {
 		return nil, errors.New(fmt.Sprintf("softlayer-go does not support service '%s'", serviceName))
@@ -47,129 +92,59 @@ func (slc *SoftLayerClient) GetService(serviceName string) (softlayer.Service, e
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Account_Service() (softlayer.SoftLayer_Account_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Account")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Account_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Account_Service](slc, "SoftLayer_Account")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Virtual_Guest_Service() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Virtual_Guest")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Virtual_Guest_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Virtual_Guest_Service](slc, "SoftLayer_Virtual_Guest")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_Service() (softlayer.SoftLayer_Dns_Domain_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Dns_Domain")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Dns_Domain_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Dns_Domain_Service](slc, "SoftLayer_Dns_Domain")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Virtual_Disk_Image_Service() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Virtual_Disk_Image")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Virtual_Disk_Image_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Virtual_Disk_Image_Service](slc, "SoftLayer_Virtual_Disk_Image")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Security_Ssh_Key_Service() (softlayer.SoftLayer_Security_Ssh_Key_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Security_Ssh_Key")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Security_Ssh_Key_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Security_Ssh_Key_Service](slc, "SoftLayer_Security_Ssh_Key")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Product_Package_Service() (softlayer.SoftLayer_Product_Package_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Product_Package")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Product_Package_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Product_Package_Service](slc, "SoftLayer_Product_Package")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Virtual_Guest_Block_Device_Template_Group")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service](slc, "SoftLayer_Virtual_Guest_Block_Device_Template_Group")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Network_Storage_Service() (softlayer.SoftLayer_Network_Storage_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Network_Storage")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Network_Storage_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Network_Storage_Service](slc, "SoftLayer_Network_Storage")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Network_Storage_Allowed_Host_Service() (softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Network_Storage_Allowed_Host")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Network_Storage_Allowed_Host_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Network_Storage_Allowed_Host_Service](slc, "SoftLayer_Network_Storage_Allowed_Host")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Product_Order_Service() (softlayer.SoftLayer_Product_Order_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Product_Order")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Product_Order_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Product_Order_Service](slc, "SoftLayer_Product_Order")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Billing_Item_Cancellation_Request_Service() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Billing_Item_Cancellation_Request")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service](slc, "SoftLayer_Billing_Item_Cancellation_Request")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Billing_Item_Service() (softlayer.SoftLayer_Billing_Item_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Billing_Item")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Billing_Item_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Billing_Item_Service](slc, "SoftLayer_Billing_Item")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Hardware_Service() (softlayer.SoftLayer_Hardware_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Hardware")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Hardware_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Hardware_Service](slc, "SoftLayer_Hardware")
 }
 
 func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord")
-	if err != nil {
-		return nil, err
-	}
-
-	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service), nil
+	return softlayer.GetService[softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service](slc, "SoftLayer_Dns_Domain_ResourceRecord")
 }
 
 //Private methods
@@ -189,4 +164,20 @@ func (slc *SoftLayerClient) initSoftLayerServices() {
 	slc.softLayerServices["SoftLayer_Hardware"] = services.NewSoftLayer_Hardware_Service(slc)
 	slc.softLayerServices["SoftLayer_Dns_Domain"] = services.NewSoftLayer_Dns_Domain_Service(slc)
 	slc.softLayerServices["SoftLayer_Dns_Domain_ResourceRecord"] = services.NewSoftLayer_Dns_Domain_ResourceRecord_Service(slc)
+
+	slc.initGeneratedSoftLayerServices()
+
+	for name, factory := range RegisteredServices() {
+		slc.softLayerServices[name] = factory(slc)
+	}
+}
+
+//Private functions
+
+func newHttpClient(authenticator Authenticator, options ...Option) softlayer.HttpClient {
+	if NewOptions(options...).Protocol == PROTOCOL_XMLRPC {
+		return NewXmlRpcClientWithOptions(authenticator, TEMPLATE_ROOT_PATH, options...)
+	}
+
+	return NewHttpClientWithOptions(authenticator, TEMPLATE_ROOT_PATH, options...)
 }
@@ -13,6 +13,7 @@ import (
 
 	slclient "github.com/maximilien/softlayer-go/client"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	softlayer_fakes "github.com/maximilien/softlayer-go/softlayer/fakes"
 )
 
 var _ = Describe("SoftLayerClient", func() {
@@ -89,6 +90,83 @@ var _ = Describe("SoftLayerClient", func() {
 		})
 	})
 
+	Context("#RegisterService", func() {
+		var fakeService *softlayer_fakes.FakeService
+
+		BeforeEach(func() {
+			fakeService = &softlayer_fakes.FakeService{}
+			fakeService.GetNameReturns("SoftLayer_Fake_Registered")
+		})
+
+		It("makes the clients created afterwards return the service of the factory", func() {
+			var factoryClient softlayer.Client
+			slclient.RegisterService("SoftLayer_Fake_Registered", func(c softlayer.Client) softlayer.Service {
+				factoryClient = c
+				return fakeService
+			})
+
+			client = slclient.NewSoftLayerClient(username, apiKey)
+
+			service, err := client.GetService("SoftLayer_Fake_Registered")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(service).To(BeIdenticalTo(fakeService))
+			Expect(factoryClient).To(BeIdenticalTo(client))
+
+			Expect(slclient.RegisteredServices()).To(HaveKey("SoftLayer_Fake_Registered"))
+		})
+
+		It("replaces a service of the client", func() {
+			fakeAccountService := &softlayer_fakes.FakeSoftLayer_Account_Service{}
+			client.(*slclient.SoftLayerClient).RegisterService("SoftLayer_Account", func(c softlayer.Client) softlayer.Service {
+				return fakeAccountService
+			})
+
+			accountService, err := client.GetSoftLayer_Account_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accountService).To(BeIdenticalTo(fakeAccountService))
+		})
+
+		It("makes the typed getter fail when the service does not implement the interface", func() {
+			client.(*slclient.SoftLayerClient).RegisterService("SoftLayer_Account", func(c softlayer.Client) softlayer.Service {
+				return fakeService
+			})
+
+			_, err := client.GetSoftLayer_Account_Service()
+			Expect(err).To(MatchError("softlayer-go service 'SoftLayer_Account' is a *softlayer_fakes.FakeService, not a softlayer.SoftLayer_Account_Service"))
+		})
+	})
+
+	Context("#Call", func() {
+		var fakeHttpClient *softlayer_fakes.FakeHttpClient
+
+		BeforeEach(func() {
+			fakeHttpClient = &softlayer_fakes.FakeHttpClient{}
+			client.(*slclient.SoftLayerClient).HttpClient = fakeHttpClient
+		})
+
+		It("sends the method with the parameters and decodes the result", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte(`[{"id": 1234, "title": "fake-title"}]`), 200, nil)
+
+			tickets := []map[string]interface{}{}
+			err := client.(*slclient.SoftLayerClient).Call("SoftLayer_Account", "getOpenTickets", 0, []interface{}{"fake-parameter"}, &tickets)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tickets).To(Equal([]map[string]interface{}{{"id": 1234.0, "title": "fake-title"}}))
+
+			Expect(fakeHttpClient.DoRequestWithContextCallCount()).To(Equal(1))
+			_, request := fakeHttpClient.DoRequestWithContextArgsForCall(0)
+			Expect(request.Path()).To(Equal("SoftLayer_Account/getOpenTickets.json"))
+			Expect(request.Parameters).To(Equal([]interface{}{"fake-parameter"}))
+		})
+
+		It("fails for error codes 40x and 50x", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte(`{"error": "fake-error", "code": "SoftLayer_Exception_ObjectNotFound"}`), 404, nil)
+
+			err := client.(*slclient.SoftLayerClient).Call("SoftLayer_Ticket", "getObject", 1234, nil, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("fake-error"))
+		})
+	})
+
 	Context("#GetSoftLayer_Account_Service", func() {
 		It("returns a instance implemementing the SoftLayer_Account_Service interface", func() {
 			var accountService softlayer.SoftLayer_Account_Service
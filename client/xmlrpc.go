@@ -0,0 +1,302 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type XmlRpcFault struct {
+	Code    string
+	Message string
+}
+
+func (f *XmlRpcFault) Error() string {
+	return fmt.Sprintf("softlayer-go: XML-RPC fault '%s': %s", f.Code, f.Message)
+}
+
+// EncodeXmlRpcMethodCall encodes a methodCall, params are encoded from their JSON representation
+// (maps become structs, slices arrays and integral numbers ints)
+func EncodeXmlRpcMethodCall(method string, params ...interface{}) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString(`<?xml version="1.0" encoding="UTF-8"?><methodCall><methodName>`)
+	xml.EscapeText(buffer, []byte(method))
+	buffer.WriteString(`</methodName><params>`)
+
+	for _, param := range params {
+		value, err := toJsonValue(param)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.WriteString(`<param>`)
+		if err := encodeXmlRpcValue(buffer, value); err != nil {
+			return nil, err
+		}
+		buffer.WriteString(`</param>`)
+	}
+
+	buffer.WriteString(`</params></methodCall>`)
+
+	return buffer.Bytes(), nil
+}
+
+// DecodeXmlRpcMethodResponse decodes the value of a methodResponse as JSON-compatible values
+// (structs become map[string]interface{}, arrays []interface{}), faults are returned as *XmlRpcFault
+func DecodeXmlRpcMethodResponse(data []byte) (interface{}, error) {
+	response := xmlRpcMethodResponse{}
+	if err := xml.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Fault != nil {
+		fault, err := response.Fault.decode()
+		if err != nil {
+			return nil, err
+		}
+
+		faultStruct, _ := fault.(map[string]interface{})
+		return nil, &XmlRpcFault{
+			Code:    fmt.Sprintf("%v", faultStruct["faultCode"]),
+			Message: fmt.Sprintf("%v", faultStruct["faultString"]),
+		}
+	}
+
+	if len(response.Params) == 0 {
+		return nil, nil
+	}
+
+	return response.Params[0].decode()
+}
+
+// DecodeXmlRpcMethodCall decodes the method name and the params of a methodCall as JSON-compatible values
+func DecodeXmlRpcMethodCall(data []byte) (string, []interface{}, error) {
+	call := xmlRpcMethodCall{}
+	if err := xml.Unmarshal(data, &call); err != nil {
+		return "", nil, err
+	}
+
+	params := make([]interface{}, len(call.Params))
+	for i, param := range call.Params {
+		value, err := param.decode()
+		if err != nil {
+			return "", nil, err
+		}
+
+		params[i] = value
+	}
+
+	return strings.TrimSpace(call.MethodName), params, nil
+}
+
+// EncodeXmlRpcMethodResponse encodes a methodResponse holding the given value, or a fault when err is an *XmlRpcFault
+func EncodeXmlRpcMethodResponse(v interface{}, err error) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString(`<?xml version="1.0" encoding="UTF-8"?><methodResponse>`)
+
+	if fault, ok := err.(*XmlRpcFault); ok {
+		buffer.WriteString(`<fault>`)
+		faultStruct := map[string]interface{}{"faultCode": fault.Code, "faultString": fault.Message}
+		if err := encodeXmlRpcValue(buffer, faultStruct); err != nil {
+			return nil, err
+		}
+		buffer.WriteString(`</fault>`)
+	} else {
+		value, err := toJsonValue(v)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.WriteString(`<params><param>`)
+		if err := encodeXmlRpcValue(buffer, value); err != nil {
+			return nil, err
+		}
+		buffer.WriteString(`</param></params>`)
+	}
+
+	buffer.WriteString(`</methodResponse>`)
+
+	return buffer.Bytes(), nil
+}
+
+// Private types
+
+type xmlRpcMethodCall struct {
+	XMLName    xml.Name      `xml:"methodCall"`
+	MethodName string        `xml:"methodName"`
+	Params     []xmlRpcValue `xml:"params>param>value"`
+}
+
+type xmlRpcMethodResponse struct {
+	XMLName xml.Name      `xml:"methodResponse"`
+	Params  []xmlRpcValue `xml:"params>param>value"`
+	Fault   *xmlRpcValue  `xml:"fault>value"`
+}
+
+type xmlRpcValue struct {
+	Int      *string       `xml:"int"`
+	I4       *string       `xml:"i4"`
+	I8       *string       `xml:"i8"`
+	Boolean  *string       `xml:"boolean"`
+	String   *string       `xml:"string"`
+	Double   *string       `xml:"double"`
+	DateTime *string       `xml:"dateTime.iso8601"`
+	Base64   *string       `xml:"base64"`
+	Struct   *xmlRpcStruct `xml:"struct"`
+	Array    *xmlRpcArray  `xml:"array"`
+	Nil      *struct{}     `xml:"nil"`
+
+	Text string `xml:",chardata"`
+}
+
+type xmlRpcStruct struct {
+	Members []xmlRpcMember `xml:"member"`
+}
+
+type xmlRpcMember struct {
+	Name  string      `xml:"name"`
+	Value xmlRpcValue `xml:"value"`
+}
+
+type xmlRpcArray struct {
+	Values []xmlRpcValue `xml:"data>value"`
+}
+
+// Private methods
+
+func (v xmlRpcValue) decode() (interface{}, error) {
+	switch {
+	case v.Int != nil:
+		return strconv.ParseInt(strings.TrimSpace(*v.Int), 10, 64)
+	case v.I4 != nil:
+		return strconv.ParseInt(strings.TrimSpace(*v.I4), 10, 64)
+	case v.I8 != nil:
+		return strconv.ParseInt(strings.TrimSpace(*v.I8), 10, 64)
+	case v.Boolean != nil:
+		return strings.TrimSpace(*v.Boolean) == "1", nil
+	case v.String != nil:
+		return *v.String, nil
+	case v.Double != nil:
+		return strconv.ParseFloat(strings.TrimSpace(*v.Double), 64)
+	case v.DateTime != nil:
+		return strings.TrimSpace(*v.DateTime), nil
+	case v.Base64 != nil:
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(*v.Base64))
+		if err != nil {
+			return nil, err
+		}
+
+		return string(decoded), nil
+	case v.Struct != nil:
+		result := map[string]interface{}{}
+		for _, member := range v.Struct.Members {
+			value, err := member.Value.decode()
+			if err != nil {
+				return nil, err
+			}
+
+			result[member.Name] = value
+		}
+
+		return result, nil
+	case v.Array != nil:
+		result := make([]interface{}, len(v.Array.Values))
+		for i, arrayValue := range v.Array.Values {
+			value, err := arrayValue.decode()
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = value
+		}
+
+		return result, nil
+	case v.Nil != nil:
+		return nil, nil
+	}
+
+	return v.Text, nil
+}
+
+// Private functions
+
+func toJsonValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func encodeXmlRpcValue(buffer *bytes.Buffer, v interface{}) error {
+	buffer.WriteString(`<value>`)
+
+	switch value := v.(type) {
+	case nil:
+		buffer.WriteString(`<nil/>`)
+	case bool:
+		if value {
+			buffer.WriteString(`<boolean>1</boolean>`)
+		} else {
+			buffer.WriteString(`<boolean>0</boolean>`)
+		}
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			fmt.Fprintf(buffer, `<int>%d</int>`, i)
+		} else {
+			fmt.Fprintf(buffer, `<double>%s</double>`, value.String())
+		}
+	case string:
+		buffer.WriteString(`<string>`)
+		xml.EscapeText(buffer, []byte(value))
+		buffer.WriteString(`</string>`)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buffer.WriteString(`<struct>`)
+		for _, key := range keys {
+			buffer.WriteString(`<member><name>`)
+			xml.EscapeText(buffer, []byte(key))
+			buffer.WriteString(`</name>`)
+			if err := encodeXmlRpcValue(buffer, value[key]); err != nil {
+				return err
+			}
+			buffer.WriteString(`</member>`)
+		}
+		buffer.WriteString(`</struct>`)
+	case []interface{}:
+		buffer.WriteString(`<array><data>`)
+		for _, arrayValue := range value {
+			if err := encodeXmlRpcValue(buffer, arrayValue); err != nil {
+				return err
+			}
+		}
+		buffer.WriteString(`</data></array>`)
+	default:
+		return errors.New(fmt.Sprintf("softlayer-go: cannot encode %s as an XML-RPC value", reflect.TypeOf(v)))
+	}
+
+	buffer.WriteString(`</value>`)
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	common "github.com/maximilien/softlayer-go/common"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// XmlRpcClient implements softlayer.HttpClient over the SoftLayer XML-RPC endpoint. It accepts the same
+// REST paths and JSON bodies as HttpClient and returns JSON responses, so services work unchanged.
+// Transport, retries, authentication and logging are those of the embedded HttpClient.
+type XmlRpcClient struct {
+	*HttpClient
+}
+
+func NewXmlRpcClient(username, apiKey, apiUrl, templatePath string, useHttps bool) *XmlRpcClient {
+	return NewXmlRpcClientWithOptions(NewBasicAuthenticator(username, apiKey), templatePath, WithBaseUrl(apiUrl), WithHttps(useHttps))
+}
+
+func NewXmlRpcClientWithOptions(authenticator Authenticator, templatePath string, options ...Option) *XmlRpcClient {
+	httpClient := NewHttpClientWithOptions(authenticator, templatePath, options...)
+	httpClient.apiUrl = xmlRpcApiUrl(httpClient.apiUrl)
+
+	return &XmlRpcClient{HttpClient: httpClient}
+}
+
+// Public methods
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.DoRawHttpRequestWithObjectMaskWithContext(context.Background(), path, masks, requestType, requestBody)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.DoRawHttpRequestWithObjectFilterWithContext(context.Background(), path, filters, requestType, requestBody)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(context.Background(), path, masks, filters, requestType, requestBody)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return slc.DoRawHttpRequestWithContext(context.Background(), path, requestType, requestBody)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	request, err := requestFromRestPath(path, requestType, requestBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	request.Mask = masks
+
+	return slc.DoRequestWithContext(ctx, request)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithObjectFilterWithContext(ctx context.Context, path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	request, err := requestFromRestPath(path, requestType, requestBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	request.Filter = filters
+
+	return slc.DoRequestWithContext(ctx, request)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx context.Context, path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	request, err := requestFromRestPath(path, requestType, requestBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	request.Mask = []string{"filteredMask[" + strings.Join(masks, ";") + "]"}
+	request.Filter = filters
+
+	return slc.DoRequestWithContext(ctx, request)
+}
+
+func (slc *XmlRpcClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	request, err := requestFromRestPath(path, requestType, requestBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return slc.DoRequestWithContext(ctx, request)
+}
+
+func (slc *XmlRpcClient) DoRequest(request *softlayer.Request) ([]byte, int, error) {
+	return slc.DoRequestWithContext(context.Background(), request)
+}
+
+func (slc *XmlRpcClient) DoRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, error) {
+	responseBody, statusCode, _, err := slc.DoPagedRequestWithContext(ctx, request)
+	return responseBody, statusCode, err
+}
+
+func (slc *XmlRpcClient) DoPagedRequest(request *softlayer.Request) ([]byte, int, int, error) {
+	return slc.DoPagedRequestWithContext(context.Background(), request)
+}
+
+func (slc *XmlRpcClient) DoPagedRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, int, error) {
+	method := xmlRpcMethodName(request)
+
+	headers, err := slc.headers(request)
+	if err != nil {
+		return nil, 0, -1, err
+	}
+
+	params := append([]interface{}{headers}, request.Parameters...)
+	requestBody, err := EncodeXmlRpcMethodCall(method, params...)
+	if err != nil {
+		return nil, 0, -1, err
+	}
+
+	responseBody, statusCode, header, err := slc.makeHttpCall(ctx, &Call{
+		Verb: request.Verb(),
+		Path: softlayer.NewRequest(request.Service, method).WithId(request.Id).Path(),
+
+		HttpMethod:  "POST",
+		Url:         fmt.Sprintf("%s/%s", slc.BaseUrl(), request.Service),
+		ContentType: "text/xml",
+		Body:        requestBody,
+	})
+	if err != nil {
+		return responseBody, statusCode, -1, err
+	}
+
+	if statusCode != http.StatusOK {
+		return responseBody, statusCode, -1, nil
+	}
+
+	value, err := DecodeXmlRpcMethodResponse(responseBody)
+	if fault, ok := err.(*XmlRpcFault); ok {
+		faultBody, _ := json.Marshal(map[string]string{"error": fault.Message, "code": fault.Code})
+		return faultBody, faultStatusCode(fault), -1, nil
+	}
+
+	if err != nil {
+		return nil, statusCode, -1, err
+	}
+
+	jsonBody, err := json.Marshal(value)
+	if err != nil {
+		return nil, statusCode, -1, err
+	}
+
+	return jsonBody, statusCode, totalItems(header), nil
+}
+
+//Private methods
+
+func (slc *XmlRpcClient) headers(request *softlayer.Request) (map[string]interface{}, error) {
+	headers := map[string]interface{}{}
+
+	if authenticator, ok := slc.Authenticator.(CredentialsAuthenticator); ok {
+		credentials, err := authenticator.Credentials()
+		if err != nil {
+			return nil, err
+		}
+
+		headers["authenticate"] = map[string]interface{}{
+			"username": credentials.Username,
+			"apiKey":   credentials.ApiKey,
+		}
+	}
+
+	if request.Id != 0 {
+		headers[request.Service+"InitParameters"] = map[string]interface{}{"id": request.Id}
+	}
+
+	if len(request.Mask) > 0 {
+		headers[request.Service+"ObjectMask"] = map[string]interface{}{"mask": request.ObjectMask()}
+	}
+
+	if request.Filter != "" {
+		var filter interface{}
+		if err := json.Unmarshal([]byte(request.Filter), &filter); err != nil {
+			return nil, fmt.Errorf("softlayer-go: invalid object filter '%s': %s", request.Filter, err.Error())
+		}
+
+		headers[request.Service+"ObjectFilter"] = filter
+	}
+
+	if limit := request.Limit(); limit > 0 {
+		headers["resultLimit"] = map[string]interface{}{
+			"limit":  limit,
+			"offset": request.Offset,
+		}
+	}
+
+	return headers, nil
+}
+
+//Private functions
+
+func xmlRpcApiUrl(apiUrl string) string {
+	if strings.HasSuffix(apiUrl, "/rest/v3") {
+		return strings.TrimSuffix(apiUrl, "/rest/v3") + "/xmlrpc/v3"
+	}
+
+	return apiUrl
+}
+
+// requestFromRestPath maps a REST path (e.g. SoftLayer_Virtual_Guest/1234/getObject.json) and its JSON body to a request
+func requestFromRestPath(path string, requestType string, requestBody *bytes.Buffer) (*softlayer.Request, error) {
+	path = strings.SplitN(path, "?", 2)[0]
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".json")
+
+	segments := strings.Split(path, "/")
+	request := softlayer.NewRequest(segments[0], "").WithHttpMethod(strings.ToUpper(requestType))
+
+	for _, segment := range segments[1:] {
+		if id, err := strconv.Atoi(segment); err == nil && request.Method == "" {
+			request.Id = id
+			continue
+		}
+
+		request.Method = segment
+	}
+
+	if requestBody != nil && requestBody.Len() > 0 {
+		body := struct {
+			Parameters []interface{} `json:"parameters"`
+		}{}
+
+		decoder := json.NewDecoder(bytes.NewReader(requestBody.Bytes()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			return nil, fmt.Errorf("softlayer-go: invalid request body for '%s': %s", path, err.Error())
+		}
+
+		request.Parameters = body.Parameters
+	}
+
+	return request, nil
+}
+
+// xmlRpcMethodName is the method of the request, or its REST equivalent when the method is implied by the HTTP verb
+func xmlRpcMethodName(request *softlayer.Request) string {
+	if request.Method != "" {
+		return request.Method
+	}
+
+	switch request.Verb() {
+	case "POST":
+		return "createObject"
+	case "PUT":
+		return "editObject"
+	case "DELETE":
+		return "deleteObject"
+	}
+
+	return "getObject"
+}
+
+func faultStatusCode(fault *XmlRpcFault) int {
+	return common.ExceptionStatusCode(fault.Code)
+}
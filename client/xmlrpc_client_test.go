@@ -0,0 +1,174 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	common "github.com/maximilien/softlayer-go/common"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("XmlRpcClient", func() {
+	var (
+		server *httptest.Server
+		client *slclient.SoftLayerClient
+
+		paths        []string
+		contentTypes []string
+		methods      []string
+		calls        [][]interface{}
+
+		result interface{}
+		fault  *slclient.XmlRpcFault
+	)
+
+	BeforeEach(func() {
+		paths = []string{}
+		contentTypes = []string{}
+		methods = []string{}
+		calls = [][]interface{}{}
+
+		result = true
+		fault = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+
+			body, _ := ioutil.ReadAll(r.Body)
+			method, params, err := slclient.DecodeXmlRpcMethodCall(body)
+			Expect(err).ToNot(HaveOccurred())
+
+			methods = append(methods, method)
+			calls = append(calls, params)
+
+			var response []byte
+			if fault != nil {
+				response, err = slclient.EncodeXmlRpcMethodResponse(nil, fault)
+			} else {
+				response, err = slclient.EncodeXmlRpcMethodResponse(result, nil)
+			}
+			Expect(err).ToNot(HaveOccurred())
+
+			w.Header().Set(slclient.SOFTLAYER_TOTAL_ITEMS_HEADER, "42")
+			w.Write(response)
+		}))
+
+		client = slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+			slclient.WithXmlRpc(),
+			slclient.WithBaseUrl(server.URL+"/xmlrpc/v3"),
+			slclient.WithLogger(slclient.NewNopLogger()),
+			slclient.WithRetryPolicy(slclient.NoRetryPolicy()),
+		)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	headers := func(call []interface{}) map[string]interface{} {
+		return call[0].(map[string]interface{})
+	}
+
+	Context("#NewSoftLayerClient", func() {
+		It("switches the default endpoints to XML-RPC", func() {
+			xmlRpcClient, ok := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithXmlRpc()).GetHttpClient().(*slclient.XmlRpcClient)
+			Expect(ok).To(BeTrue())
+			Expect(xmlRpcClient.BaseUrl()).To(Equal("https://api.softlayer.com/xmlrpc/v3"))
+
+			xmlRpcClient = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithPrivateEndpoint(), slclient.WithXmlRpc()).GetHttpClient().(*slclient.XmlRpcClient)
+			Expect(xmlRpcClient.BaseUrl()).To(Equal("https://api.service.softlayer.com/xmlrpc/v3"))
+		})
+	})
+
+	Context("services over XML-RPC", func() {
+		It("gets an object with its id, mask and credentials in the headers", func() {
+			result = map[string]interface{}{"id": 1234, "hostname": "fake-hostname", "hourlyBillingFlag": true}
+
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuest, err := virtualGuestService.WithMask("id", "hostname").GetObject(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Id).To(Equal(1234))
+			Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+			Expect(virtualGuest.HourlyBillingFlag).To(BeTrue())
+
+			Expect(paths).To(Equal([]string{"/xmlrpc/v3/SoftLayer_Virtual_Guest"}))
+			Expect(contentTypes).To(Equal([]string{"text/xml"}))
+			Expect(methods).To(Equal([]string{"getObject"}))
+			Expect(headers(calls[0])).To(Equal(map[string]interface{}{
+				"authenticate":                          map[string]interface{}{"username": "fake-username", "apiKey": "fake-api-key"},
+				"SoftLayer_Virtual_GuestInitParameters": map[string]interface{}{"id": int64(1234)},
+				"SoftLayer_Virtual_GuestObjectMask":     map[string]interface{}{"mask": "mask[id;hostname]"},
+			}))
+		})
+
+		It("sends the parameters after the headers", func() {
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			tagged, err := virtualGuestService.SetTags(1234, []string{"tag1", "tag2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tagged).To(BeTrue())
+
+			Expect(methods).To(Equal([]string{"setTags"}))
+			Expect(calls[0][1:]).To(Equal([]interface{}{"tag1, tag2"}))
+		})
+
+		It("maps REST verbs without method to their XML-RPC method", func() {
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			deleted, err := virtualGuestService.DeleteObject(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(methods).To(Equal([]string{"deleteObject"}))
+		})
+
+		It("sends object filters and result limits as headers", func() {
+			result = []interface{}{map[string]interface{}{"id": 1}}
+
+			_, _, totalItems, err := client.GetHttpClient().DoPagedRequest(softlayer.NewRequest("SoftLayer_Account", "getVirtualGuests").
+				WithFilter(`{"virtualGuests":{"hostname":{"operation":"foo"}}}`).
+				WithResultLimit(10).
+				WithOffset(20))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(totalItems).To(Equal(42))
+
+			Expect(headers(calls[0])["SoftLayer_AccountObjectFilter"]).To(Equal(map[string]interface{}{
+				"virtualGuests": map[string]interface{}{"hostname": map[string]interface{}{"operation": "foo"}},
+			}))
+			Expect(headers(calls[0])["resultLimit"]).To(Equal(map[string]interface{}{"limit": int64(10), "offset": int64(20)}))
+		})
+
+		It("sends an offset without result limit with the default result limit", func() {
+			result = []interface{}{map[string]interface{}{"id": 1}}
+
+			_, _, _, err := client.GetHttpClient().DoPagedRequest(softlayer.NewRequest("SoftLayer_Account", "getVirtualGuests").WithOffset(20))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(headers(calls[0])["resultLimit"]).To(Equal(map[string]interface{}{"limit": int64(softlayer.DEFAULT_RESULT_LIMIT), "offset": int64(20)}))
+		})
+
+		It("returns faults as SoftLayerErrors", func() {
+			fault = &slclient.XmlRpcFault{Code: "SoftLayer_Exception_ObjectNotFound", Message: "Unable to find object with id of '1234'."}
+
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = virtualGuestService.GetPowerState(1234)
+			Expect(err).To(HaveOccurred())
+			Expect(common.IsNotFound(err)).To(BeTrue())
+
+			slError, ok := common.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slError.StatusCode).To(Equal(404))
+			Expect(slError.Message).To(Equal("Unable to find object with id of '1234'."))
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package client_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("XML-RPC", func() {
+	Context("#EncodeXmlRpcMethodCall", func() {
+		It("encodes the params from their JSON representation", func() {
+			params := map[string]interface{}{
+				"hostname": "a<b",
+				"cpus":     2,
+				"ratio":    0.5,
+				"hourly":   true,
+				"disks":    []interface{}{"100", nil},
+			}
+
+			data, err := slclient.EncodeXmlRpcMethodCall("createObject", params)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`<?xml version="1.0" encoding="UTF-8"?><methodCall><methodName>createObject</methodName><params><param><value><struct>` +
+				`<member><name>cpus</name><value><int>2</int></value></member>` +
+				`<member><name>disks</name><value><array><data><value><string>100</string></value><value><nil/></value></data></array></value></member>` +
+				`<member><name>hostname</name><value><string>a&lt;b</string></value></member>` +
+				`<member><name>hourly</name><value><boolean>1</boolean></value></member>` +
+				`<member><name>ratio</name><value><double>0.5</double></value></member>` +
+				`</struct></value></param></params></methodCall>`))
+		})
+
+		It("round trips through DecodeXmlRpcMethodCall", func() {
+			data, err := slclient.EncodeXmlRpcMethodCall("setTags", map[string]interface{}{"id": 1234}, "tag1,tag2")
+			Expect(err).ToNot(HaveOccurred())
+
+			method, params, err := slclient.DecodeXmlRpcMethodCall(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(method).To(Equal("setTags"))
+			Expect(params).To(Equal([]interface{}{map[string]interface{}{"id": int64(1234)}, "tag1,tag2"}))
+		})
+	})
+
+	Context("#DecodeXmlRpcMethodResponse", func() {
+		It("decodes all the XML-RPC types", func() {
+			value, err := slclient.DecodeXmlRpcMethodResponse([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<methodResponse>
+  <params>
+    <param>
+      <value>
+        <struct>
+          <member><name>id</name><value><int>1234</int></value></member>
+          <member><name>maxMemory</name><value><i4>1024</i4></value></member>
+          <member><name>hostname</name><value><string>fake-hostname</string></value></member>
+          <member><name>domain</name><value>untyped.com</value></member>
+          <member><name>hourlyBillingFlag</name><value><boolean>1</boolean></value></member>
+          <member><name>ratio</name><value><double>1.5</double></value></member>
+          <member><name>createDate</name><value><dateTime.iso8601>2016-03-11T10:00:00-06:00</dateTime.iso8601></value></member>
+          <member><name>userData</name><value><base64>aGVsbG8=</base64></value></member>
+          <member><name>notes</name><value><nil/></value></member>
+          <member><name>tags</name><value><array><data><value><string>a</string></value><value><string>b</string></value></data></array></value></member>
+        </struct>
+      </value>
+    </param>
+  </params>
+</methodResponse>`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(map[string]interface{}{
+				"id":                int64(1234),
+				"maxMemory":         int64(1024),
+				"hostname":          "fake-hostname",
+				"domain":            "untyped.com",
+				"hourlyBillingFlag": true,
+				"ratio":             1.5,
+				"createDate":        "2016-03-11T10:00:00-06:00",
+				"userData":          "hello",
+				"notes":             nil,
+				"tags":              []interface{}{"a", "b"},
+			}))
+		})
+
+		It("returns faults as *XmlRpcFault", func() {
+			data, err := slclient.EncodeXmlRpcMethodResponse(nil, &slclient.XmlRpcFault{Code: "SoftLayer_Exception_ObjectNotFound", Message: "Unable to find object with id of '1'."})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = slclient.DecodeXmlRpcMethodResponse(data)
+			Expect(err).To(Equal(&slclient.XmlRpcFault{Code: "SoftLayer_Exception_ObjectNotFound", Message: "Unable to find object with id of '1'."}))
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND    = "SoftLayer_Exception_ObjectNotFound"
+	SOFTLAYER_EXCEPTION_NOT_FOUND           = "SoftLayer_Exception_NotFound"
+	SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED = "SoftLayer_Exception_WebService_RateLimitExceeded"
+)
+
+type SoftLayerError struct {
+	StatusCode int
+
+	//SoftLayer exception code, e.g. SoftLayer_Exception_ObjectNotFound
+	Code    string
+	Message string
+
+	Service string
+	Method  string
+}
+
+func NewSoftLayerError(service string, method string, statusCode int, responseBody []byte) *SoftLayerError {
+	slError := &SoftLayerError{
+		StatusCode: statusCode,
+		Service:    service,
+		Method:     method,
+	}
+
+	apiError := struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	if err := json.Unmarshal(responseBody, &apiError); err == nil {
+		slError.Code = apiError.Code
+		slError.Message = apiError.Error
+	}
+
+	return slError
+}
+
+// WithService sets the service and method of the call on a SoftLayerError missing them, e.g. returned by
+// HttpClient#HasErrors for an error in the body of a successful response
+func WithService(err error, service string, method string) error {
+	if slError, ok := AsSoftLayerError(err); ok && slError.Service == "" {
+		slError.Service = service
+		slError.Method = method
+	}
+
+	return err
+}
+
+// ExceptionStatusCode is the HTTP status code matching a SoftLayer exception code, for errors reported without one
+func ExceptionStatusCode(code string) int {
+	switch code {
+	case SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND, SOFTLAYER_EXCEPTION_NOT_FOUND:
+		return http.StatusNotFound
+	case SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED:
+		return http.StatusTooManyRequests
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (e *SoftLayerError) Error() string {
+	if e.Service == "" {
+		return e.Message
+	}
+
+	errorMessage := fmt.Sprintf("softlayer-go: could not %s#%s, HTTP error code: '%d'", e.Service, e.Method, e.StatusCode)
+	if e.Code != "" {
+		errorMessage += fmt.Sprintf(", exception: '%s'", e.Code)
+	}
+	if e.Message != "" {
+		errorMessage += fmt.Sprintf(", message: '%s'", e.Message)
+	}
+
+	return errorMessage
+}
+
+func AsSoftLayerError(err error) (*SoftLayerError, bool) {
+	var slError *SoftLayerError
+	if errors.As(err, &slError) {
+		return slError, true
+	}
+
+	return nil, false
+}
+
+func IsNotFound(err error) bool {
+	slError, ok := AsSoftLayerError(err)
+	if !ok {
+		return false
+	}
+
+	return slError.StatusCode == http.StatusNotFound ||
+		slError.Code == SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND ||
+		slError.Code == SOFTLAYER_EXCEPTION_NOT_FOUND
+}
+
+func IsRateLimited(err error) bool {
+	slError, ok := AsSoftLayerError(err)
+	if !ok {
+		return false
+	}
+
+	return slError.StatusCode == http.StatusTooManyRequests ||
+		slError.Code == SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED
+}
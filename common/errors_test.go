@@ -0,0 +1,110 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/maximilien/softlayer-go/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SoftLayerError", func() {
+	var slError *SoftLayerError
+
+	Context("#NewSoftLayerError", func() {
+		It("parses the SoftLayer exception code and message from the response body", func() {
+			slError = NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 404, []byte(`{"error":"Unable to find object with id of '1234'.","code":"SoftLayer_Exception_ObjectNotFound"}`))
+			Expect(slError.StatusCode).To(Equal(404))
+			Expect(slError.Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(slError.Method).To(Equal("getObject"))
+			Expect(slError.Code).To(Equal("SoftLayer_Exception_ObjectNotFound"))
+			Expect(slError.Message).To(Equal("Unable to find object with id of '1234'."))
+		})
+
+		It("ignores response bodies which are not JSON", func() {
+			slError = NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 502, []byte(`<html>Bad Gateway</html>`))
+			Expect(slError.StatusCode).To(Equal(502))
+			Expect(slError.Code).To(Equal(""))
+			Expect(slError.Message).To(Equal(""))
+		})
+	})
+
+	Context("#Error", func() {
+		It("includes the service, method and HTTP status code", func() {
+			slError = NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 500, nil)
+			Expect(slError.Error()).To(Equal("softlayer-go: could not SoftLayer_Virtual_Guest#getObject, HTTP error code: '500'"))
+		})
+
+		It("includes the exception code and message when available", func() {
+			slError = NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 404, []byte(`{"error":"not found","code":"SoftLayer_Exception_ObjectNotFound"}`))
+			Expect(slError.Error()).To(Equal("softlayer-go: could not SoftLayer_Virtual_Guest#getObject, HTTP error code: '404', exception: 'SoftLayer_Exception_ObjectNotFound', message: 'not found'"))
+		})
+	})
+
+	Context("#IsNotFound", func() {
+		It("returns true for HTTP 404", func() {
+			Expect(IsNotFound(NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 404, nil))).To(BeTrue())
+		})
+
+		It("returns true for SoftLayer not found exceptions", func() {
+			Expect(IsNotFound(&SoftLayerError{StatusCode: 500, Code: "SoftLayer_Exception_ObjectNotFound"})).To(BeTrue())
+		})
+
+		It("returns true for wrapped errors", func() {
+			err := fmt.Errorf("wrapped: %w", NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 404, nil))
+			Expect(IsNotFound(err)).To(BeTrue())
+		})
+
+		It("returns false for other errors", func() {
+			Expect(IsNotFound(NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", 500, nil))).To(BeFalse())
+			Expect(IsNotFound(errors.New("fake-error"))).To(BeFalse())
+			Expect(IsNotFound(nil)).To(BeFalse())
+		})
+	})
+
+	Context("#WithService", func() {
+		It("sets the service and method of a SoftLayerError without them", func() {
+			err := WithService(&SoftLayerError{StatusCode: 404, Message: "fake-error"}, "SoftLayer_Virtual_Guest", "createObject")
+
+			slError, ok := AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slError.Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(slError.Method).To(Equal("createObject"))
+			Expect(err.Error()).To(Equal("softlayer-go: could not SoftLayer_Virtual_Guest#createObject, HTTP error code: '404', message: 'fake-error'"))
+		})
+
+		It("keeps the service of a SoftLayerError and other errors", func() {
+			slError := NewSoftLayerError("SoftLayer_Account", "getVirtualGuests", 500, nil)
+			Expect(WithService(slError, "SoftLayer_Virtual_Guest", "createObject")).To(Equal(slError))
+			Expect(slError.Service).To(Equal("SoftLayer_Account"))
+
+			Expect(WithService(errors.New("fake-error"), "SoftLayer_Virtual_Guest", "createObject")).To(MatchError("fake-error"))
+		})
+	})
+
+	Context("#ExceptionStatusCode", func() {
+		It("maps the SoftLayer exception codes to HTTP status codes", func() {
+			Expect(ExceptionStatusCode("SoftLayer_Exception_ObjectNotFound")).To(Equal(404))
+			Expect(ExceptionStatusCode("SoftLayer_Exception_NotFound")).To(Equal(404))
+			Expect(ExceptionStatusCode("SoftLayer_Exception_WebService_RateLimitExceeded")).To(Equal(429))
+			Expect(ExceptionStatusCode("SoftLayer_Exception_Public")).To(Equal(500))
+		})
+	})
+
+	Context("#IsRateLimited", func() {
+		It("returns true for HTTP 429", func() {
+			Expect(IsRateLimited(NewSoftLayerError("SoftLayer_Account", "getVirtualGuests", 429, nil))).To(BeTrue())
+		})
+
+		It("returns true for SoftLayer rate limit exceptions", func() {
+			Expect(IsRateLimited(&SoftLayerError{StatusCode: 500, Code: "SoftLayer_Exception_WebService_RateLimitExceeded"})).To(BeTrue())
+		})
+
+		It("returns false for other errors", func() {
+			Expect(IsRateLimited(NewSoftLayerError("SoftLayer_Account", "getVirtualGuests", 500, nil))).To(BeFalse())
+			Expect(IsRateLimited(errors.New("fake-error"))).To(BeFalse())
+		})
+	})
+})
@@ -24,6 +24,7 @@ type SoftLayer_Billing_Item struct {
 	RecurringFee          string     `json:"recurringFee,omitempty"`
 	RecurringFeeTaxRate   string     `json:"recurringFeeTaxRate,omitempty"`
 	RecurringMonths       int        `json:"recurringMonths,omitempty"`
+	ResourceTableId       int        `json:"resourceTableId,omitempty"`
 	ServiceProviderId     int        `json:"serviceProviderId,omitempty"`
 	SetupFee              string     `json:"setupFee,omitempty"`
 	SetupFeeTaxRate       string     `json:"setupFeeTaxRate,omitempty"`
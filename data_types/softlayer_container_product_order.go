@@ -58,3 +58,45 @@ type Property struct {
 type VirtualGuest struct {
 	Id int `json:"id"`
 }
+
+// SoftLayer_Product_Order_Price_Summary is the cost of an order verified or quoted by SoftLayer_Product_Order.
+// The recurring fees are per hour when HourlyPricing is set, otherwise per month.
+type SoftLayer_Product_Order_Price_Summary struct {
+	Items []SoftLayer_Product_Order_Item_Price `json:"items"`
+
+	HourlyPricing bool `json:"hourlyPricing"`
+
+	// Pre-tax fees (preTaxRecurring, preTaxRecurringHourly, preTaxRecurringMonthly and preTaxSetup)
+	RecurringFee        float64 `json:"recurringFee"`
+	HourlyRecurringFee  float64 `json:"hourlyRecurringFee"`
+	MonthlyRecurringFee float64 `json:"monthlyRecurringFee"`
+	SetupFee            float64 `json:"setupFee"`
+
+	// Tax of the recurring and setup fees (totalRecurringTax and totalSetupTax)
+	RecurringTax float64 `json:"recurringTax"`
+	SetupTax     float64 `json:"setupTax"`
+
+	// Fees including their tax (postTaxRecurring and postTaxSetup)
+	TotalRecurringFee float64 `json:"totalRecurringFee"`
+	TotalSetupFee     float64 `json:"totalSetupFee"`
+}
+
+type SoftLayer_Product_Order_Item_Price struct {
+	PriceId      int    `json:"priceId"`
+	ItemId       int    `json:"itemId"`
+	Description  string `json:"description,omitempty"`
+	CategoryCode string `json:"categoryCode,omitempty"`
+
+	HourlyRecurringFee float64 `json:"hourlyRecurringFee"`
+	RecurringFee       float64 `json:"recurringFee"`
+	SetupFee           float64 `json:"setupFee"`
+	OneTimeFee         float64 `json:"oneTimeFee"`
+	LaborFee           float64 `json:"laborFee"`
+}
+
+type SoftLayer_Product_Order_Quote struct {
+	QuoteId int    `json:"quoteId"`
+	Name    string `json:"name,omitempty"`
+
+	Summary SoftLayer_Product_Order_Price_Summary `json:"summary"`
+}
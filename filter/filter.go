@@ -0,0 +1,181 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const DATE_FORMAT = "01/02/2006 15:04:05"
+
+type Option struct {
+	Name  string        `json:"name"`
+	Value []interface{} `json:"value"`
+}
+
+// Filter is a single condition on a property path of a SoftLayer objectFilter, e.g.
+//
+//	filter.Path("virtualGuests.primaryIpAddress").Eq("10.0.0.1")
+type Filter struct {
+	Path      string
+	Operation interface{}
+	Options   []Option
+}
+
+func Path(path string) Filter {
+	return Filter{Path: path}
+}
+
+func Tags(path string, tags ...string) Filter {
+	return Path(strings.TrimSuffix(path, ".") + ".tagReferences.tag.name").In(toValues(tags)...)
+}
+
+func (f Filter) Eq(value interface{}) Filter {
+	f.Operation = normalize(value)
+	return f
+}
+
+func (f Filter) NotEq(value interface{}) Filter {
+	return f.withOperator("!=", value)
+}
+
+func (f Filter) Contains(value string) Filter {
+	return f.withOperator("*=", value)
+}
+
+func (f Filter) StartsWith(value string) Filter {
+	return f.withOperator("^=", value)
+}
+
+func (f Filter) EndsWith(value string) Filter {
+	return f.withOperator("$=", value)
+}
+
+func (f Filter) In(values ...interface{}) Filter {
+	return f.withOptions("in", Option{Name: "data", Value: normalizeAll(values)})
+}
+
+// Gt filters on values greater than the given number, or on dates after the given time.Time
+func (f Filter) Gt(value interface{}) Filter {
+	if date, ok := value.(time.Time); ok {
+		return f.withOptions("greaterThanDate", dateOption(date))
+	}
+
+	return f.withOperator(">", value)
+}
+
+// Lt filters on values lower than the given number, or on dates before the given time.Time
+func (f Filter) Lt(value interface{}) Filter {
+	if date, ok := value.(time.Time); ok {
+		return f.withOptions("lessThanDate", dateOption(date))
+	}
+
+	return f.withOperator("<", value)
+}
+
+func (f Filter) Between(start time.Time, end time.Time) Filter {
+	return f.withOptions("betweenDate",
+		Option{Name: "startDate", Value: []interface{}{start.Format(DATE_FORMAT)}},
+		Option{Name: "endDate", Value: []interface{}{end.Format(DATE_FORMAT)}},
+	)
+}
+
+func (f Filter) IsNull() Filter {
+	f.Operation = "is null"
+	return f
+}
+
+func (f Filter) NotNull() Filter {
+	f.Operation = "not null"
+	return f
+}
+
+// OrderBy sorts the results on the property, direction is ASC or DESC
+func (f Filter) OrderBy(direction string) Filter {
+	return f.withOptions("orderBy", Option{Name: "sort", Value: []interface{}{strings.ToUpper(direction)}})
+}
+
+func (f Filter) Build() string {
+	return Build(f)
+}
+
+// Build merges the filters into a single SoftLayer objectFilter JSON string
+func Build(filters ...Filter) string {
+	root := map[string]interface{}{}
+
+	for _, f := range filters {
+		node := root
+		for _, property := range strings.Split(f.Path, ".") {
+			child, ok := node[property].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[property] = child
+			}
+			node = child
+		}
+
+		if f.Operation != nil {
+			node["operation"] = f.Operation
+		}
+
+		if len(f.Options) > 0 {
+			node["options"] = f.Options
+		}
+	}
+
+	objectFilter, err := json.Marshal(root)
+	if err != nil {
+		return "{}"
+	}
+
+	return string(objectFilter)
+}
+
+//Private methods
+
+func (f Filter) withOperator(operator string, value interface{}) Filter {
+	f.Operation = fmt.Sprintf("%s %v", operator, value)
+	return f
+}
+
+func (f Filter) withOptions(operation string, options ...Option) Filter {
+	f.Operation = operation
+	f.Options = options
+	return f
+}
+
+//Private functions
+
+func dateOption(date time.Time) Option {
+	return Option{Name: "date", Value: []interface{}{date.Format(DATE_FORMAT)}}
+}
+
+func toValues(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+
+	return result
+}
+
+func normalizeAll(values []interface{}) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = normalize(value)
+	}
+
+	return result
+}
+
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v
+	case time.Time:
+		return v.Format(DATE_FORMAT)
+	default:
+		return fmt.Sprint(v)
+	}
+}
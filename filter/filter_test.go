@@ -0,0 +1,84 @@
+package filter_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	filter "github.com/maximilien/softlayer-go/filter"
+)
+
+var _ = Describe("Filter", func() {
+	Context("#Eq", func() {
+		It("quotes string values", func() {
+			Expect(filter.Path("virtualGuests.primaryBackendIpAddress").Eq("10.0.0.1").Build()).To(MatchJSON(`{"virtualGuests":{"primaryBackendIpAddress":{"operation":"10.0.0.1"}}}`))
+		})
+
+		It("keeps numbers as numbers", func() {
+			Expect(filter.Path("virtualGuests.id").Eq(1234).Build()).To(MatchJSON(`{"virtualGuests":{"id":{"operation":1234}}}`))
+		})
+	})
+
+	Context("#NotEq, #Contains, #StartsWith, #EndsWith", func() {
+		It("prefixes the value with the SoftLayer operator", func() {
+			Expect(filter.Path("hostname").NotEq("foo").Build()).To(MatchJSON(`{"hostname":{"operation":"!= foo"}}`))
+			Expect(filter.Path("hostname").Contains("foo").Build()).To(MatchJSON(`{"hostname":{"operation":"*= foo"}}`))
+			Expect(filter.Path("hostname").StartsWith("foo").Build()).To(MatchJSON(`{"hostname":{"operation":"^= foo"}}`))
+			Expect(filter.Path("hostname").EndsWith("foo").Build()).To(MatchJSON(`{"hostname":{"operation":"$= foo"}}`))
+		})
+	})
+
+	Context("#In", func() {
+		It("uses the in operation with data options", func() {
+			Expect(filter.Path("virtualGuests.id").In(1, 2, 3).Build()).To(MatchJSON(`{"virtualGuests":{"id":{"operation":"in","options":[{"name":"data","value":[1,2,3]}]}}}`))
+		})
+	})
+
+	Context("#Gt and #Lt", func() {
+		It("compares numbers", func() {
+			Expect(filter.Path("maxMemory").Gt(1024).Build()).To(MatchJSON(`{"maxMemory":{"operation":"> 1024"}}`))
+			Expect(filter.Path("maxMemory").Lt(4096).Build()).To(MatchJSON(`{"maxMemory":{"operation":"< 4096"}}`))
+		})
+
+		It("compares dates", func() {
+			date := time.Date(2016, time.March, 1, 14, 30, 0, 0, time.UTC)
+			Expect(filter.Path("createDate").Gt(date).Build()).To(MatchJSON(`{"createDate":{"operation":"greaterThanDate","options":[{"name":"date","value":["03/01/2016 14:30:00"]}]}}`))
+			Expect(filter.Path("createDate").Lt(date).Build()).To(MatchJSON(`{"createDate":{"operation":"lessThanDate","options":[{"name":"date","value":["03/01/2016 14:30:00"]}]}}`))
+		})
+	})
+
+	Context("#Between", func() {
+		It("uses the betweenDate operation", func() {
+			start := time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)
+			end := time.Date(2016, time.April, 1, 0, 0, 0, 0, time.UTC)
+			Expect(filter.Path("createDate").Between(start, end).Build()).To(MatchJSON(`{"createDate":{"operation":"betweenDate","options":[{"name":"startDate","value":["03/01/2016 00:00:00"]},{"name":"endDate","value":["04/01/2016 00:00:00"]}]}}`))
+		})
+	})
+
+	Context("#OrderBy", func() {
+		It("sorts on the property", func() {
+			Expect(filter.Path("virtualGuests.id").OrderBy("desc").Build()).To(MatchJSON(`{"virtualGuests":{"id":{"operation":"orderBy","options":[{"name":"sort","value":["DESC"]}]}}}`))
+		})
+	})
+
+	Context("#Tags", func() {
+		It("filters on tag references", func() {
+			Expect(filter.Tags("virtualGuests", "tag1", "tag2").Build()).To(MatchJSON(`{"virtualGuests":{"tagReferences":{"tag":{"name":{"operation":"in","options":[{"name":"data","value":["tag1","tag2"]}]}}}}}`))
+		})
+	})
+
+	Context("#Build", func() {
+		It("merges filters sharing a path prefix", func() {
+			objectFilter := filter.Build(
+				filter.Path("virtualGuests.hostname").StartsWith("bosh-"),
+				filter.Path("virtualGuests.datacenter.name").Eq("dal05"),
+			)
+			Expect(objectFilter).To(MatchJSON(`{"virtualGuests":{"hostname":{"operation":"^= bosh-"},"datacenter":{"name":{"operation":"dal05"}}}}`))
+		})
+
+		It("returns an empty filter when no filters are passed", func() {
+			Expect(filter.Build()).To(Equal("{}"))
+		})
+	})
+})
@@ -0,0 +1,669 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const GENERATED_HEADER = "// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT."
+
+const (
+	DATA_TYPES_DIR = "data_types"
+	INTERFACES_DIR = "softlayer"
+	SERVICES_DIR   = "services"
+	CLIENT_DIR     = "client"
+	FAKES_DIR      = "client/fakes"
+	FIXTURES_DIR   = "test_fixtures/services"
+)
+
+// Generator writes the data_types structs, softlayer interfaces, services implementations and their fixture-driven
+// tests of the types of a Metadata, skipping the hand-written ones found under its root
+type Generator struct {
+	metadata   Metadata
+	root       string
+	outputRoot string
+
+	handWrittenDataTypes map[string]bool
+	handWrittenServices  map[string]bool
+
+	//Properties, parameters and methods left out because they use types unknown to the repo, e.g. for the command to report
+	Skipped []string
+}
+
+func NewGenerator(metadata Metadata, root string) *Generator {
+	return &Generator{
+		metadata:   metadata,
+		root:       root,
+		outputRoot: root,
+	}
+}
+
+// WithOutputRoot writes the generated files under outputRoot instead of the root of the repo
+func (g *Generator) WithOutputRoot(outputRoot string) *Generator {
+	g.outputRoot = outputRoot
+	return g
+}
+
+// Generate writes the generated files and returns their paths, relative to the output root. Fixtures are only written
+// when missing, so that they can be replaced by captured responses.
+func (g *Generator) Generate() ([]string, error) {
+	var err error
+
+	g.Skipped = []string{}
+
+	g.handWrittenDataTypes, err = scanTypeNames(filepath.Join(g.root, DATA_TYPES_DIR))
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces, err := scanTypeNames(filepath.Join(g.root, INTERFACES_DIR))
+	if err != nil {
+		return nil, err
+	}
+
+	g.handWrittenServices = map[string]bool{}
+	for name := range interfaces {
+		if strings.HasSuffix(name, "_Service") {
+			g.handWrittenServices[strings.TrimSuffix(name, "_Service")] = true
+		}
+	}
+
+	files := map[string][]byte{}
+	fixtures := map[string][]byte{}
+	services := []serviceView{}
+
+	for _, name := range g.typeNames() {
+		if g.generatesDataType(name) {
+			files[filepath.Join(DATA_TYPES_DIR, fileName(name)+".go")], err = g.renderDataType(g.metadata[name])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		service, ok := g.serviceView(g.metadata[name])
+		if !ok {
+			continue
+		}
+
+		services = append(services, service)
+
+		files[filepath.Join(INTERFACES_DIR, fileName(name)+"_service.go")], err = renderGoFile(interfaceTemplate, service)
+		if err != nil {
+			return nil, err
+		}
+
+		files[filepath.Join(SERVICES_DIR, fileName(name)+".go")], err = renderGoFile(serviceTemplate, service)
+		if err != nil {
+			return nil, err
+		}
+
+		files[filepath.Join(SERVICES_DIR, fileName(name)+"_test.go")], err = renderGoFile(serviceTestTemplate, service)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, method := range service.Methods {
+			if method.Fixture != "" {
+				fixtures[filepath.Join(FIXTURES_DIR, method.Fixture)] = g.fixture(method.metadata)
+			}
+		}
+	}
+
+	clients := map[string]clientView{
+		filepath.Join(CLIENT_DIR, "generated_services.go"): {Package: "client", Receiver: "slc", Type: "SoftLayerClient", ServicesField: "softLayerServices", Services: services},
+		filepath.Join(FAKES_DIR, "generated_services.go"):  {Package: "client_fakes", Receiver: "fslc", Type: "FakeSoftLayerClient", ServicesField: "SoftLayerServices", Services: services},
+	}
+
+	for path, client := range clients {
+		files[path], err = renderGoFile(clientTemplate, client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files[filepath.Join(INTERFACES_DIR, "generated_services.go")], err = renderGoFile(generatedServicesTemplate, services)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.write(files, fixtures)
+}
+
+// Private methods
+
+func (g *Generator) typeNames() []string {
+	names := []string{}
+	for name := range g.metadata {
+		names = append(names, name)
+	}
+
+	return sortedStrings(names)
+}
+
+func (g *Generator) generatesDataType(name string) bool {
+	t, ok := g.metadata[name]
+	return ok && !g.handWrittenDataTypes[name] && len(t.Properties) > 0
+}
+
+func (g *Generator) isKnownType(name string) bool {
+	return g.handWrittenDataTypes[name] || g.generatesDataType(name)
+}
+
+// goType returns the Go type of a SoftLayer type, false when the type is unknown to the repo
+func (g *Generator) goType(name string, array bool, qualifier string) (string, bool) {
+	var goType string
+
+	switch name {
+	case "int", "integer", "long", "unsignedInt", "unsignedLong":
+		goType = "int"
+	case "string":
+		goType = "string"
+	case "boolean":
+		goType = "bool"
+	case "float", "decimal":
+		goType = "float64"
+	case "dateTime":
+		goType = "time.Time"
+	default:
+		if !g.isKnownType(name) {
+			return "", false
+		}
+
+		goType = qualifier + name
+	}
+
+	if array {
+		return "[]" + goType, true
+	}
+
+	return goType, true
+}
+
+func (g *Generator) renderDataType(t Type) ([]byte, error) {
+	view := dataTypeView{Name: t.Name}
+
+	for _, name := range propertyNames(t.Properties) {
+		property := t.Properties[name]
+
+		goType, ok := g.goType(property.Type, property.TypeArray, "")
+		if !ok {
+			g.Skipped = append(g.Skipped, fmt.Sprintf("%s.%s: unknown type %s", t.Name, name, property.Type))
+			continue
+		}
+
+		if !property.TypeArray && (property.Type == "dateTime" || property.Form == "relational") {
+			goType = "*" + goType
+		}
+
+		view.Fields = append(view.Fields, fieldView{Name: exportedName(name), GoType: goType, JsonName: name})
+	}
+
+	return renderGoFile(dataTypeTemplate, view)
+}
+
+func (g *Generator) serviceView(t Type) (serviceView, bool) {
+	if t.NoService || g.handWrittenServices[t.Name] {
+		return serviceView{}, false
+	}
+
+	view := serviceView{
+		Name:       t.Name,
+		Receiver:   receiverName(t.Name),
+		VarName:    varName(t.Name),
+		MaskObject: "nil",
+	}
+
+	if g.isKnownType(t.Name) {
+		view.MaskObject = "datatypes." + t.Name + "{}"
+	}
+
+	for _, name := range methodNames(t.Methods) {
+		method, ok := g.methodView(t.Name, t.Methods[name])
+		if ok {
+			view.Methods = append(view.Methods, method)
+		}
+	}
+
+	return view, len(view.Methods) > 0
+}
+
+func (g *Generator) methodView(service string, method Method) (methodView, bool) {
+	view := methodView{
+		Name:     method.Name,
+		GoName:   exportedName(method.Name),
+		Static:   method.Static,
+		metadata: method,
+	}
+
+	if view.GoName == "GetName" || view.GoName == "WithMask" {
+		g.Skipped = append(g.Skipped, fmt.Sprintf("%s::%s: conflicts with the softlayer.Service methods", service, method.Name))
+		return methodView{}, false
+	}
+
+	if method.Type != "void" {
+		resultType, ok := g.goType(method.Type, method.TypeArray, "datatypes.")
+		if !ok {
+			g.Skipped = append(g.Skipped, fmt.Sprintf("%s::%s: unknown type %s", service, method.Name, method.Type))
+			return methodView{}, false
+		}
+
+		view.ResultType = resultType
+		view.ZeroValue = zeroValue(resultType)
+		view.Fixture = fmt.Sprintf("%s_Service_%s.json", service, method.Name)
+	}
+
+	for i, parameter := range method.Parameters {
+		goType, ok := g.goType(parameter.Type, parameter.TypeArray, "datatypes.")
+		if ok {
+			view.Params = append(view.Params, paramView{Name: paramName(parameter.Name), GoType: goType})
+			continue
+		}
+
+		//SoftLayer parameters are positional, the trailing ones being optional
+		for _, next := range method.Parameters[i:] {
+			if _, known := g.goType(next.Type, next.TypeArray, "datatypes."); known {
+				g.Skipped = append(g.Skipped, fmt.Sprintf("%s::%s: unknown type %s of parameter %s", service, method.Name, parameter.Type, parameter.Name))
+				return methodView{}, false
+			}
+
+			g.Skipped = append(g.Skipped, fmt.Sprintf("%s::%s: optional parameter %s of unknown type %s", service, method.Name, next.Name, next.Type))
+		}
+
+		break
+	}
+
+	view.HttpMethod = "GET"
+	if len(view.Params) > 0 {
+		view.HttpMethod = "POST"
+	}
+
+	view.PathPattern = service
+	if !method.Static {
+		view.PathPattern += "/1234"
+	}
+	view.PathPattern += "/" + method.Name + `\.json`
+
+	return view, true
+}
+
+// fixture returns a sample response of the method, built from the metadata of its type
+func (g *Generator) fixture(method Method) []byte {
+	value := g.sampleValue(method.Type, method.TypeArray, "result", 0)
+
+	data, _ := json.MarshalIndent(value, "", "  ")
+
+	return append(data, '\n')
+}
+
+func (g *Generator) sampleValue(typeName string, array bool, name string, depth int) interface{} {
+	if array {
+		return []interface{}{g.sampleValue(typeName, false, name, depth)}
+	}
+
+	switch typeName {
+	case "int", "integer", "long", "unsignedInt", "unsignedLong":
+		return 1234
+	case "string":
+		return "fake-" + name
+	case "boolean":
+		return true
+	case "float", "decimal":
+		return 12.34
+	case "dateTime":
+		return "2016-01-01T00:00:00-06:00"
+	}
+
+	value := map[string]interface{}{}
+	for propertyName, property := range g.metadata[typeName].Properties {
+		if property.Form == "relational" && depth > 0 {
+			continue
+		}
+
+		if _, ok := g.goType(property.Type, property.TypeArray, ""); ok {
+			value[propertyName] = g.sampleValue(property.Type, property.TypeArray, propertyName, depth+1)
+		}
+	}
+
+	return value
+}
+
+func (g *Generator) write(files map[string][]byte, fixtures map[string][]byte) ([]string, error) {
+	written := []string{}
+
+	for _, path := range filePaths(files) {
+		fullPath := filepath.Join(g.outputRoot, path)
+
+		existing, err := ioutil.ReadFile(fullPath)
+		if err == nil && !isGenerated(existing) {
+			return written, errors.New(fmt.Sprintf("generator: %s exists and is not generated, remove it or the type from the metadata", path))
+		}
+
+		err = writeFile(fullPath, files[path])
+		if err != nil {
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	for _, path := range filePaths(fixtures) {
+		fullPath := filepath.Join(g.outputRoot, path)
+
+		if _, err := os.Stat(fullPath); err == nil {
+			continue
+		}
+
+		err := writeFile(fullPath, fixtures[path])
+		if err != nil {
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	return written, nil
+}
+
+// Private functions
+
+// scanTypeNames returns the names of the types declared by the hand-written Go files of the directory
+func scanTypeNames(dir string) (map[string]bool, error) {
+	names := map[string]bool{}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		source, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if isGenerated(source) {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, source, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				names[spec.(*ast.TypeSpec).Name.Name] = true
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func isGenerated(source []byte) bool {
+	return bytes.HasPrefix(source, []byte(GENERATED_HEADER))
+}
+
+func renderGoFile(tmpl *template.Template, data interface{}) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+
+	err := tmpl.Execute(buffer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("generator: cannot format %s: %s\n%s", tmpl.Name(), err, buffer.String()))
+	}
+
+	return source, nil
+}
+
+func writeFile(path string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func sortedStrings(keys []string) []string {
+	sort.Strings(keys)
+	return keys
+}
+
+func propertyNames(properties map[string]Property) []string {
+	names := []string{}
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	return sortedStrings(names)
+}
+
+func methodNames(methods map[string]Method) []string {
+	names := []string{}
+	for name := range methods {
+		names = append(names, name)
+	}
+
+	return sortedStrings(names)
+}
+
+func filePaths(files map[string][]byte) []string {
+	paths := []string{}
+	for path := range files {
+		paths = append(paths, path)
+	}
+
+	return sortedStrings(paths)
+}
+
+var camelCaseBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// fileName returns the snake case file name of a type, e.g. softlayer_dns_domain_resource_record for SoftLayer_Dns_Domain_ResourceRecord
+func fileName(typeName string) string {
+	name := strings.Replace(typeName, "SoftLayer", "Softlayer", 1)
+	return strings.ToLower(camelCaseBoundary.ReplaceAllString(name, "${1}_${2}"))
+}
+
+// receiverName returns the initials of a type, e.g. sltss for SoftLayer_Ticket_Subject
+func receiverName(typeName string) string {
+	receiver := "sl"
+	for _, part := range strings.Split(strings.TrimPrefix(typeName, "SoftLayer_"), "_") {
+		if part != "" {
+			receiver += strings.ToLower(part[:1])
+		}
+	}
+
+	return receiver + "s"
+}
+
+// varName returns the name of a service in the tests, e.g. ticketSubjectService for SoftLayer_Ticket_Subject
+func varName(typeName string) string {
+	name := strings.Replace(strings.TrimPrefix(typeName, "SoftLayer_"), "_", "", -1)
+	return strings.ToLower(name[:1]) + name[1:] + "Service"
+}
+
+func exportedName(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return string(runes)
+}
+
+func paramName(name string) string {
+	if token.IsKeyword(name) || name == "id" || name == "ctx" {
+		return name + "Parameter"
+	}
+
+	return name
+}
+
+func zeroValue(goType string) string {
+	switch {
+	case strings.HasPrefix(goType, "[]"):
+		return goType + "{}"
+	case goType == "int" || goType == "float64":
+		return "0"
+	case goType == "string":
+		return `""`
+	case goType == "bool":
+		return "false"
+	default:
+		return goType + "{}"
+	}
+}
+
+// Private types
+
+type dataTypeView struct {
+	Name   string
+	Fields []fieldView
+}
+
+func (v dataTypeView) UsesTime() bool {
+	for _, field := range v.Fields {
+		if strings.Contains(field.GoType, "time.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+type fieldView struct {
+	Name     string
+	GoType   string
+	JsonName string
+}
+
+type serviceView struct {
+	Name     string
+	Receiver string
+	VarName  string
+	Methods  []methodView
+
+	//Object the WithMask masks are validated against, nil when the service has no data type
+	MaskObject string
+}
+
+// StructName is the unexported name of the implementation of the service, e.g. softLayer_Ticket_Service
+func (v serviceView) StructName() string {
+	return "s" + v.Name[1:] + "_Service"
+}
+
+// MaskedMethods are the GET methods, other than getObject, returning the service type which WithMask applies to
+func (v serviceView) MaskedMethods() []string {
+	methods := []string{}
+	for _, method := range v.Methods {
+		if method.Name == "getObject" || method.HttpMethod != "GET" {
+			continue
+		}
+
+		if method.ResultType == "datatypes."+v.Name || method.ResultType == "[]datatypes."+v.Name {
+			methods = append(methods, method.Name)
+		}
+	}
+
+	return methods
+}
+
+func (v serviceView) UsesDatatypes() bool {
+	return v.uses("datatypes.") || v.MaskObject != "nil"
+}
+
+func (v serviceView) UsesTime() bool {
+	return v.uses("time.")
+}
+
+func (v serviceView) UsesResults() bool {
+	for _, method := range v.Methods {
+		if method.ResultType != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v serviceView) UsesParams() bool {
+	for _, method := range v.Methods {
+		if len(method.Params) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v serviceView) uses(qualifier string) bool {
+	for _, method := range v.Methods {
+		if strings.Contains(method.ResultType, qualifier) {
+			return true
+		}
+
+		for _, param := range method.Params {
+			if strings.Contains(param.GoType, qualifier) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type methodView struct {
+	Name   string
+	GoName string
+	Static bool
+	Params []paramView
+
+	//Empty for void methods
+	ResultType string
+	ZeroValue  string
+	Fixture    string
+
+	HttpMethod  string
+	PathPattern string
+
+	metadata Method
+}
+
+type paramView struct {
+	Name   string
+	GoType string
+}
+
+type clientView struct {
+	Package       string
+	Receiver      string
+	Type          string
+	ServicesField string
+	Services      []serviceView
+}
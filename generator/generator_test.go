@@ -0,0 +1,143 @@
+package generator_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	generator "github.com/maximilien/softlayer-go/generator"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		metadata   generator.Metadata
+		outputRoot string
+
+		gen *generator.Generator
+		err error
+	)
+
+	BeforeEach(func() {
+		metadata, err = generator.LoadMetadata("softlayer_metadata.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		outputRoot, err = ioutil.TempDir("", "slgo-generate")
+		Expect(err).ToNot(HaveOccurred())
+
+		gen = generator.NewGenerator(metadata, "..").WithOutputRoot(outputRoot)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(outputRoot)
+	})
+
+	Context("#Generate", func() {
+		It("matches the checked-in generated code", func() {
+			files, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).ToNot(BeEmpty())
+
+			for _, file := range files {
+				if strings.HasPrefix(file, generator.FIXTURES_DIR) {
+					continue
+				}
+
+				generated, err := ioutil.ReadFile(filepath.Join(outputRoot, file))
+				Expect(err).ToNot(HaveOccurred())
+
+				checkedIn, err := ioutil.ReadFile(filepath.Join("..", file))
+				Expect(err).ToNot(HaveOccurred(), "run go run ./generator/slgo-generate to generate %s", file)
+				Expect(string(generated)).To(Equal(string(checkedIn)), "run go run ./generator/slgo-generate to update %s", file)
+			}
+		})
+
+		It("generates the data types, interfaces, services and tests of the metadata", func() {
+			files, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(files).To(ContainElement(filepath.Join("data_types", "softlayer_ticket.go")))
+			Expect(files).To(ContainElement(filepath.Join("softlayer", "softlayer_ticket_service.go")))
+			Expect(files).To(ContainElement(filepath.Join("services", "softlayer_ticket.go")))
+			Expect(files).To(ContainElement(filepath.Join("services", "softlayer_ticket_test.go")))
+			Expect(files).To(ContainElement(filepath.Join("test_fixtures", "services", "SoftLayer_Ticket_Service_getObject.json")))
+		})
+
+		It("skips the hand-written data types and services", func() {
+			files, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(files).ToNot(ContainElement(filepath.Join("data_types", "softlayer_location.go")))
+			Expect(files).To(ContainElement(filepath.Join("services", "softlayer_location.go")))
+
+			Expect(files).ToNot(ContainElement(filepath.Join("data_types", "softlayer_virtual_guest.go")))
+			Expect(files).ToNot(ContainElement(filepath.Join("services", "softlayer_virtual_guest.go")))
+		})
+
+		It("skips the properties and methods of unknown types and drops the trailing optional parameters", func() {
+			_, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(gen.Skipped).To(ConsistOf(
+				"SoftLayer_Location_Datacenter.locationAddress: unknown type SoftLayer_Account_Address",
+				"SoftLayer_Location_Datacenter::getLocationAddress: unknown type SoftLayer_Account_Address",
+				"SoftLayer_Ticket::createStandardTicket: optional parameter attachedFiles of unknown type SoftLayer_Container_Utility_File_Attachment",
+			))
+
+			service, err := ioutil.ReadFile(filepath.Join(outputRoot, "softlayer", "softlayer_ticket_service.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(service)).To(ContainSubstring("CreateStandardTicket(templateObject datatypes.SoftLayer_Ticket, contents string, attachmentId int, rootPassword string) (datatypes.SoftLayer_Ticket, error)"))
+		})
+
+		It("skips the methods with a parameter of unknown type followed by known ones", func() {
+			method := metadata["SoftLayer_Ticket"].Methods["removeAttachedHardware"]
+			method.Parameters = []generator.Parameter{
+				{Name: "hardware", Type: "SoftLayer_Hardware_Unknown"},
+				{Name: "hardwareId", Type: "int"},
+			}
+			metadata["SoftLayer_Ticket"].Methods["removeAttachedHardware"] = method
+
+			_, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gen.Skipped).To(ContainElement("SoftLayer_Ticket::removeAttachedHardware: unknown type SoftLayer_Hardware_Unknown of parameter hardware"))
+
+			service, err := ioutil.ReadFile(filepath.Join(outputRoot, "softlayer", "softlayer_ticket_service.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(service)).ToNot(ContainSubstring("RemoveAttachedHardware"))
+		})
+
+		It("keeps the existing fixtures", func() {
+			fixture := filepath.Join(outputRoot, "test_fixtures", "services", "SoftLayer_Ticket_Service_getObject.json")
+			Expect(os.MkdirAll(filepath.Dir(fixture), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(fixture, []byte(`{"id": 5678}`), 0644)).To(Succeed())
+
+			files, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).ToNot(ContainElement(filepath.Join("test_fixtures", "services", "SoftLayer_Ticket_Service_getObject.json")))
+
+			content, err := ioutil.ReadFile(fixture)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal(`{"id": 5678}`))
+		})
+
+		It("fails rather than overwrite a hand-written file", func() {
+			dataType := filepath.Join(outputRoot, "data_types", "softlayer_ticket.go")
+			Expect(os.MkdirAll(filepath.Dir(dataType), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(dataType, []byte("package data_types\n"), 0644)).To(Succeed())
+
+			_, err := gen.Generate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("data_types/softlayer_ticket.go exists and is not generated"))
+		})
+	})
+
+	Context(".LoadMetadata", func() {
+		It("fails for a missing file", func() {
+			_, err := generator.LoadMetadata("missing_metadata.json")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Metadata is a SoftLayer API metadata dump (https://api.softlayer.com/metadata/v3.1), keyed by type name
+type Metadata map[string]Type
+
+type Type struct {
+	Name      string `json:"name"`
+	Base      string `json:"base"`
+	TypeDoc   string `json:"typeDoc"`
+	NoService bool   `json:"noservice"`
+
+	Properties map[string]Property `json:"properties"`
+	Methods    map[string]Method   `json:"methods"`
+}
+
+type Property struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TypeArray bool   `json:"typeArray"`
+
+	//local, relational or count
+	Form string `json:"form"`
+}
+
+type Method struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TypeArray bool   `json:"typeArray"`
+	Static    bool   `json:"static"`
+
+	Parameters []Parameter `json:"parameters"`
+
+	Limitable  bool `json:"limitable"`
+	Filterable bool `json:"filterable"`
+	Maskable   bool `json:"maskable"`
+}
+
+type Parameter struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TypeArray bool   `json:"typeArray"`
+}
+
+func LoadMetadata(path string) (Metadata, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := Metadata{}
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
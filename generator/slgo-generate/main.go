@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	generator "github.com/maximilien/softlayer-go/generator"
+)
+
+func main() {
+	metadataPath := flag.String("metadata", "generator/softlayer_metadata.json", "path of the SoftLayer API metadata dump")
+	root := flag.String("root", ".", "root of the softlayer-go repo")
+	verbose := flag.Bool("v", false, "print the properties and methods skipped because of types unknown to the repo")
+	flag.Parse()
+
+	metadata, err := generator.LoadMetadata(*metadataPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-generate: cannot load the metadata: %s\n", err)
+		os.Exit(1)
+	}
+
+	gen := generator.NewGenerator(metadata, *root)
+
+	files, err := gen.Generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-generate: %s\n", err)
+		os.Exit(1)
+	}
+
+	for _, file := range files {
+		fmt.Println(file)
+	}
+
+	if *verbose {
+		for _, skipped := range gen.Skipped {
+			fmt.Fprintf(os.Stderr, "skipped %s\n", skipped)
+		}
+	}
+}
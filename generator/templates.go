@@ -0,0 +1,273 @@
+package generator
+
+import (
+	"text/template"
+)
+
+var dataTypeTemplate = template.Must(template.New("data type").Parse(`// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT.
+
+package data_types
+{{if .UsesTime}}
+import (
+	"time"
+)
+{{end}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}} ` + "`" + `json:"{{.JsonName}},omitempty"` + "`" + `
+{{- end}}
+}
+`))
+
+var interfaceTemplate = template.Must(template.New("interface").Parse(`// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT.
+
+package softlayer
+
+import (
+	"context"
+{{- if .UsesTime}}
+	"time"
+{{- end}}
+{{if .UsesDatatypes}}
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+{{- end}}
+)
+
+type {{.Name}}_Service interface {
+	Service
+
+	WithMask(mask ...string) {{.Name}}_Service
+{{range .Methods}}
+	{{.GoName}}({{template "params" .}}) {{template "results" .}}
+	{{.GoName}}WithContext(ctx context.Context{{if not .Static}}, id int{{end}}{{range .Params}}, {{.Name}} {{.GoType}}{{end}}) {{template "results" .}}
+{{- end}}
+}
+{{define "params"}}{{if not .Static}}id int{{if .Params}}, {{end}}{{end}}{{range $i, $param := .Params}}{{if $i}}, {{end}}{{$param.Name}} {{$param.GoType}}{{end}}{{end}}
+{{- define "results"}}{{if .ResultType}}({{.ResultType}}, error){{else}}error{{end}}{{end}}
+`))
+
+var serviceTemplate = template.Must(template.Must(interfaceTemplate.Clone()).New("service").Parse(`// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT.
+
+package services
+
+import (
+	"context"
+{{- if .UsesResults}}
+	"encoding/json"
+{{- end}}
+{{- if .UsesTime}}
+	"time"
+{{- end}}
+
+	common "github.com/maximilien/softlayer-go/common"
+{{- if .UsesDatatypes}}
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+{{- end}}
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type {{.StructName}} struct {
+	client softlayer.Client
+}
+
+func New{{.Name}}_Service(client softlayer.Client) *{{.StructName}} {
+	return &{{.StructName}}{
+		client: client,
+	}
+}
+
+func ({{.Receiver}} *{{.StructName}}) GetName() string {
+	return "{{.Name}}"
+}
+
+func ({{.Receiver}} *{{.StructName}}) WithMask(mask ...string) softlayer.{{.Name}}_Service {
+	if len(mask) == 0 {
+		return {{.Receiver}}
+	}
+
+	return New{{.Name}}_Service(newObjectMaskClient({{.Receiver}}.client, mask, {{.MaskObject}}{{range .MaskedMethods}}, "{{.}}"{{end}}))
+}
+{{$service := .}}
+{{- range .Methods}}
+func ({{$service.Receiver}} *{{$service.StructName}}) {{.GoName}}({{template "params" .}}) {{template "results" .}} {
+	return {{$service.Receiver}}.{{.GoName}}WithContext(context.Background(){{if not .Static}}, id{{end}}{{range .Params}}, {{.Name}}{{end}})
+}
+
+func ({{$service.Receiver}} *{{$service.StructName}}) {{.GoName}}WithContext(ctx context.Context{{if not .Static}}, id int{{end}}{{range .Params}}, {{.Name}} {{.GoType}}{{end}}) {{template "results" .}} {
+	request := softlayer.NewRequest({{$service.Receiver}}.GetName(), "{{.Name}}")
+{{- if not .Static}}.WithId(id){{end}}
+{{- if .Params}}.WithParameters({{range $i, $param := .Params}}{{if $i}}, {{end}}{{$param.Name}}{{end}}){{end}}
+
+	response, errorCode, err := {{$service.Receiver}}.client.GetHttpClient().DoRequestWithContext(ctx, request)
+	if err != nil {
+		return {{if .ResultType}}{{.ZeroValue}}, {{end}}err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		return {{if .ResultType}}{{.ZeroValue}}, {{end}}common.NewSoftLayerError({{$service.Receiver}}.GetName(), "{{.Name}}", errorCode, response)
+	}
+{{if .ResultType}}
+	result := {{.ZeroValue}}
+	err = json.Unmarshal(response, &result)
+	if err != nil {
+		return {{.ZeroValue}}, err
+	}
+
+	return result, nil
+{{- else}}
+	return nil
+{{- end}}
+}
+{{end}}`))
+
+var serviceTestTemplate = template.Must(template.New("service test").Funcs(template.FuncMap{"zeroValue": zeroValue}).Parse(`// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT.
+
+package services_test
+
+import (
+{{- if or .UsesResults .UsesParams}}
+	"encoding/json"
+{{- end}}
+	"os"
+{{- if .UsesTime}}
+	"time"
+{{- end}}
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+{{- if .UsesDatatypes}}
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+{{- end}}
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+{{- if .UsesResults}}
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+{{- end}}
+)
+
+var _ = Describe("{{.Name}}", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		{{.VarName}} softlayer.{{.Name}}_Service
+		err error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		{{.VarName}}, err = fakeClient.Get{{.Name}}_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect({{.VarName}}).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := {{.VarName}}.GetName()
+			Expect(name).To(Equal("{{.Name}}"))
+		})
+	})
+{{$service := .}}
+{{- range .Methods}}
+	Context("#{{.GoName}}", func() {
+{{- if .ResultType}}
+		It("returns the {{.ResultType}} of the response", func() {
+			fakeClient.FakeHttpClient.Route("{{.HttpMethod}}", ` + "`" + `{{.PathPattern}}` + "`" + `).RespondWithFixture("{{.Fixture}}")
+
+			result, err := {{$service.VarName}}.{{.GoName}}({{template "args" .}})
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := testhelpers.ReadJsonTestFixtures("services", "{{.Fixture}}")
+			Expect(err).ToNot(HaveOccurred())
+
+			expected := {{.ZeroValue}}
+			Expect(json.Unmarshal(response, &expected)).To(Succeed())
+			Expect(result).To(Equal(expected))
+		})
+{{- else}}
+		It("succeeds", func() {
+			fakeClient.FakeHttpClient.Route("{{.HttpMethod}}", ` + "`" + `{{.PathPattern}}` + "`" + `).Respond([]byte("null"))
+
+			err := {{$service.VarName}}.{{.GoName}}({{template "args" .}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+{{- end}}
+{{if .Params}}
+		It("sends the parameters", func() {
+			fakeClient.FakeHttpClient.Route("{{.HttpMethod}}", ` + "`" + `{{.PathPattern}}` + "`" + `).Respond([]byte("null"))
+
+			{{if .ResultType}}_, {{end}}err := {{$service.VarName}}.{{.GoName}}({{template "args" .}})
+			Expect(err).ToNot(HaveOccurred())
+
+			calls := fakeClient.FakeHttpClient.CallsTo("{{.HttpMethod}}", ` + "`" + `{{.PathPattern}}` + "`" + `)
+			Expect(calls).To(HaveLen(1))
+
+			parameters, err := json.Marshal(map[string]interface{}{"parameters": []interface{}{ {{- template "paramArgs" .}}}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls[0].Body).To(MatchJSON(parameters))
+		})
+{{end}}
+		It("fails for error codes 40x and 50x", func() {
+			errorCodes := []int{400, 404, 500, 503}
+
+			route := fakeClient.FakeHttpClient.Route("{{.HttpMethod}}", ` + "`" + `{{.PathPattern}}` + "`" + `)
+			for _, errorCode := range errorCodes {
+				route.RespondWithStatus(errorCode, []byte(` + "`" + `{"error": "fake-error"}` + "`" + `))
+			}
+
+			for range errorCodes {
+				{{if .ResultType}}_, {{end}}err := {{$service.VarName}}.{{.GoName}}({{template "args" .}})
+				Expect(err).To(HaveOccurred())
+			}
+		})
+	})
+{{end -}}
+})
+{{define "args"}}{{if not .Static}}1234{{if .Params}}, {{end}}{{end}}{{template "paramArgs" .}}{{end}}
+{{- define "paramArgs"}}{{range $i, $param := .Params}}{{if $i}}, {{end}}{{$param.GoType | zeroValue}}{{end}}{{end}}
+`))
+
+var clientTemplate = template.Must(template.New("client").Parse(`// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	services "github.com/maximilien/softlayer-go/services"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+{{$client := .}}
+{{- range .Services}}
+func ({{$client.Receiver}} *{{$client.Type}}) Get{{.Name}}_Service() (softlayer.{{.Name}}_Service, error) {
+	return softlayer.GetService[softlayer.{{.Name}}_Service]({{$client.Receiver}}, "{{.Name}}")
+}
+{{end}}
+//Private methods
+
+func ({{.Receiver}} *{{.Type}}) initGeneratedSoftLayerServices() {
+{{- range .Services}}
+	{{$client.Receiver}}.{{$client.ServicesField}}["{{.Name}}"] = services.New{{.Name}}_Service({{$client.Receiver}})
+{{- end}}
+}
+`))
+
+var generatedServicesTemplate = template.Must(template.New("generated services").Parse(`// Code generated by generator/slgo-generate from the SoftLayer API metadata. DO NOT EDIT.
+
+package softlayer
+
+// GeneratedServices are the getters of the services generated from the SoftLayer API metadata
+type GeneratedServices interface {
+{{- range .}}
+	Get{{.Name}}_Service() ({{.Name}}_Service, error)
+{{- end}}
+}
+`))
@@ -30,10 +30,7 @@ var _ = Describe("SoftLayer Security SSH keys", func() {
 
 			testhelpers.WaitForCreatedSshKeyToBePresent(createdSshKey.Id)
 
-			sshKeyService, err := testhelpers.CreateSecuritySshKeyService()
-			Expect(err).ToNot(HaveOccurred())
-
-			result, err := sshKeyService.GetObject(createdSshKey.Id)
+			result, err := securityService.GetObject(createdSshKey.Id)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(result.CreateDate).ToNot(BeNil())
@@ -52,9 +49,9 @@ var _ = Describe("SoftLayer Security SSH keys", func() {
 			result.Label = "TEST:softlayer-go:edited-label"
 			result.Notes = "TEST:softlayer-go:edited-notes"
 			result.Key = newPublicKey
-			sshKeyService.EditObject(createdSshKey.Id, result)
+			securityService.EditObject(createdSshKey.Id, result)
 
-			result2, err := sshKeyService.GetObject(createdSshKey.Id)
+			result2, err := securityService.GetObject(createdSshKey.Id)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.CreateDate).To(Equal(result2.CreateDate))
 			Expect(result2.Label).To(Equal("TEST:softlayer-go:edited-label"))
@@ -66,7 +63,7 @@ var _ = Describe("SoftLayer Security SSH keys", func() {
 			Expect(result2.Key).To(Equal(oldPublicKey))
 			Expect(result2.Fingerprint).To(Equal(oldFingerprint))
 
-			deleted, err := sshKeyService.DeleteObject(createdSshKey.Id)
+			deleted, err := securityService.DeleteObject(createdSshKey.Id)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(deleted).To(BeTrue())
 
@@ -8,25 +8,13 @@ import (
 	. "github.com/onsi/gomega"
 
 	datatypes "github.com/maximilien/softlayer-go/data_types"
-	softlayer "github.com/maximilien/softlayer-go/softlayer"
 	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
 )
 
 var _ = Describe("SoftLayer Virtual Guest Lifecycle", func() {
-	var (
-		err error
-
-		accountService      softlayer.SoftLayer_Account_Service
-		virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service
-	)
+	var err error
 
 	BeforeEach(func() {
-		accountService, err = testhelpers.CreateAccountService()
-		Expect(err).ToNot(HaveOccurred())
-
-		virtualGuestService, err = testhelpers.CreateVirtualGuestService()
-		Expect(err).ToNot(HaveOccurred())
-
 		testhelpers.TIMEOUT = 35 * time.Minute
 		testhelpers.POLLING_INTERVAL = 10 * time.Second
 	})
@@ -0,0 +1,258 @@
+package mask
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Mask is an ordered set of (possibly relational) SoftLayer object mask properties, e.g.
+//
+//	mask.New("id", "hostname", "primaryNetworkComponent.networkVlan.id")
+type Mask struct {
+	properties []string
+}
+
+func New(properties ...string) Mask {
+	return Mask{}.Add(properties...)
+}
+
+// FromStruct derives a mask from the JSON tags of a data_types struct: all its local properties plus
+// the local properties of each of the given relational properties, e.g.
+//
+//	mask.FromStruct(datatypes.SoftLayer_Virtual_Guest{}, "datacenter", "operatingSystem.passwords")
+func FromStruct(v interface{}, relationalProperties ...string) (Mask, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return Mask{}, errors.New("softlayer-go: cannot derive an object mask from nil")
+	}
+
+	m := New(localProperties(t)...)
+
+	for _, relationalProperty := range relationalProperties {
+		relationalType, err := propertyType(t, relationalProperty)
+		if err != nil {
+			return Mask{}, err
+		}
+
+		for _, property := range localProperties(relationalType) {
+			m = m.Add(relationalProperty + "." + property)
+		}
+	}
+
+	return m, nil
+}
+
+func (m Mask) Add(properties ...string) Mask {
+	result := Mask{properties: append([]string{}, m.properties...)}
+
+	for _, property := range properties {
+		property = strings.Trim(strings.TrimSpace(property), ".")
+		if property == "" || result.Contains(property) {
+			continue
+		}
+
+		result.properties = append(result.properties, property)
+	}
+
+	return result
+}
+
+func (m Mask) Merge(others ...Mask) Mask {
+	result := m
+	for _, other := range others {
+		result = result.Add(other.properties...)
+	}
+
+	return result
+}
+
+func (m Mask) Contains(property string) bool {
+	for _, p := range m.properties {
+		if p == property {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m Mask) IsEmpty() bool {
+	return len(m.properties) == 0
+}
+
+// Properties returns the dotted properties of the mask, as accepted by the HttpClient DoRawHttpRequestWithObjectMask* methods
+func (m Mask) Properties() []string {
+	return append([]string{}, m.properties...)
+}
+
+// String returns the mask in the SoftLayer mask[...] syntax, relational properties sharing a prefix are nested, e.g.
+//
+//	mask[id;primaryNetworkComponent[networkVlan[id;vlanNumber]]]
+func (m Mask) String() string {
+	root := &node{}
+	for _, property := range m.properties {
+		root.add(strings.Split(property, "."))
+	}
+
+	return "mask[" + root.childrenString() + "]"
+}
+
+// Validate checks that every property of the mask exists in the JSON tags of the given data_types struct
+func (m Mask) Validate(v interface{}) error {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return errors.New("softlayer-go: cannot validate an object mask against nil")
+	}
+
+	for _, property := range m.properties {
+		if _, err := propertyType(t, property); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//Private types
+
+type node struct {
+	name     string
+	children []*node
+}
+
+func (n *node) add(path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	for _, child := range n.children {
+		if child.name == path[0] {
+			child.add(path[1:])
+			return
+		}
+	}
+
+	child := &node{name: path[0]}
+	n.children = append(n.children, child)
+	child.add(path[1:])
+}
+
+func (n *node) childrenString() string {
+	children := make([]string, len(n.children))
+	for i, child := range n.children {
+		children[i] = child.String()
+	}
+
+	return strings.Join(children, ";")
+}
+
+func (n *node) String() string {
+	if len(n.children) == 0 {
+		return n.name
+	}
+
+	return n.name + "[" + n.childrenString() + "]"
+}
+
+//Private functions
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func isRelational(t reflect.Type) bool {
+	t = elemType(t)
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+func jsonName(field reflect.StructField) string {
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	if tag == "-" {
+		return ""
+	}
+
+	if tag == "" {
+		return strings.ToLower(field.Name[:1]) + field.Name[1:]
+	}
+
+	return tag
+}
+
+func localProperties(t reflect.Type) []string {
+	t = elemType(t)
+	if t.Kind() != reflect.Struct {
+		return []string{}
+	}
+
+	properties := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if field.Anonymous && elemType(field.Type).Kind() == reflect.Struct {
+			properties = append(properties, localProperties(field.Type)...)
+			continue
+		}
+
+		name := jsonName(field)
+		if name == "" || isRelational(field.Type) {
+			continue
+		}
+
+		properties = append(properties, name)
+	}
+
+	return properties
+}
+
+func findField(t reflect.Type, name string) (reflect.StructField, bool) {
+	t = elemType(t)
+	if t.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if field.Anonymous && elemType(field.Type).Kind() == reflect.Struct {
+			if embedded, ok := findField(field.Type, name); ok {
+				return embedded, true
+			}
+			continue
+		}
+
+		if jsonName(field) == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func propertyType(t reflect.Type, property string) (reflect.Type, error) {
+	current := t
+	for _, name := range strings.Split(property, ".") {
+		field, ok := findField(current, name)
+		if !ok {
+			return nil, fmt.Errorf("softlayer-go: object mask property '%s' does not exist in %s", property, elemType(t).Name())
+		}
+
+		current = field.Type
+	}
+
+	return current, nil
+}
@@ -0,0 +1,13 @@
+package mask_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestMask(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mask Suite")
+}
@@ -0,0 +1,77 @@
+package mask_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	mask "github.com/maximilien/softlayer-go/mask"
+)
+
+var _ = Describe("Mask", func() {
+	Context("#New and #Add", func() {
+		It("keeps the properties in order without duplicates", func() {
+			m := mask.New("id", "hostname").Add("id", "domain")
+			Expect(m.Properties()).To(Equal([]string{"id", "hostname", "domain"}))
+		})
+
+		It("does not modify the original mask", func() {
+			m := mask.New("id")
+			m.Add("hostname")
+			Expect(m.Properties()).To(Equal([]string{"id"}))
+		})
+	})
+
+	Context("#Merge", func() {
+		It("adds the properties of the other masks", func() {
+			m := mask.New("id").Merge(mask.New("hostname"), mask.New("id", "domain"))
+			Expect(m.Properties()).To(Equal([]string{"id", "hostname", "domain"}))
+		})
+	})
+
+	Context("#String", func() {
+		It("nests relational properties", func() {
+			m := mask.New("id", "primaryNetworkComponent.networkVlan.id", "primaryNetworkComponent.networkVlan.vlanNumber", "datacenter.name")
+			Expect(m.String()).To(Equal("mask[id;primaryNetworkComponent[networkVlan[id;vlanNumber]];datacenter[name]]"))
+		})
+	})
+
+	Context("#FromStruct", func() {
+		It("derives the local properties from the JSON tags", func() {
+			m, err := mask.FromStruct(datatypes.SoftLayer_Virtual_Guest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.Contains("id")).To(BeTrue())
+			Expect(m.Contains("hostname")).To(BeTrue())
+			Expect(m.Contains("createDate")).To(BeTrue())
+			Expect(m.Contains("datacenter")).To(BeFalse())
+			Expect(m.Contains("operatingSystem")).To(BeFalse())
+		})
+
+		It("adds the local properties of relational properties", func() {
+			m, err := mask.FromStruct(&datatypes.SoftLayer_Virtual_Guest{}, "datacenter", "operatingSystem.passwords")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.Contains("datacenter.name")).To(BeTrue())
+			Expect(m.Contains("datacenter.longName")).To(BeTrue())
+			Expect(m.Contains("operatingSystem.passwords.username")).To(BeTrue())
+			Expect(m.Contains("operatingSystem.passwords.password")).To(BeTrue())
+		})
+
+		It("fails for unknown relational properties", func() {
+			_, err := mask.FromStruct(datatypes.SoftLayer_Virtual_Guest{}, "fake-property")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#Validate", func() {
+		It("accepts properties which exist in the struct", func() {
+			m := mask.New("id", "datacenter.name", "primaryNetworkComponent.networkVlan.id", "operatingSystem.passwords.password")
+			Expect(m.Validate(datatypes.SoftLayer_Virtual_Guest{})).To(Succeed())
+		})
+
+		It("rejects properties which do not exist in the struct", func() {
+			err := mask.New("id", "datacenter.fakeProperty").Validate(datatypes.SoftLayer_Virtual_Guest{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("datacenter.fakeProperty"))
+		})
+	})
+})
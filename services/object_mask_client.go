@@ -0,0 +1,182 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+
+	mask "github.com/maximilien/softlayer-go/mask"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// objectMaskClient makes the GETs of getObject, and of the given methods returning the service type, use a caller
+// provided object mask instead of the service default. The mask is validated against the service type (when it has
+// one) and an invalid mask fails these calls without sending them. All the other calls are sent unchanged.
+type objectMaskClient struct {
+	softlayer.Client
+
+	mask    []string
+	methods []string
+	err     error
+}
+
+func newObjectMaskClient(client softlayer.Client, objectMask []string, object interface{}, methods ...string) softlayer.Client {
+	if omc, ok := client.(*objectMaskClient); ok {
+		client = omc.Client
+	}
+
+	var err error
+	if object != nil {
+		err = mask.New(objectMask...).Validate(object)
+	}
+
+	return &objectMaskClient{
+		Client:  client,
+		mask:    objectMask,
+		methods: methods,
+		err:     err,
+	}
+}
+
+func (omc *objectMaskClient) GetHttpClient() softlayer.HttpClient {
+	return &objectMaskHttpClient{
+		HttpClient: omc.Client.GetHttpClient(),
+		mask:       omc.mask,
+		methods:    omc.methods,
+		err:        omc.err,
+	}
+}
+
+type objectMaskHttpClient struct {
+	softlayer.HttpClient
+
+	mask    []string
+	methods []string
+	err     error
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return omhc.DoRawHttpRequestWithContext(context.Background(), path, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return omhc.DoRawHttpRequestWithObjectMaskWithContext(context.Background(), path, masks, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return omhc.DoRawHttpRequestWithObjectFilterWithContext(context.Background(), path, filters, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	return omhc.DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(context.Background(), path, masks, filters, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	if !omhc.isMaskedCall(requestType, path) {
+		return omhc.HttpClient.DoRawHttpRequestWithContext(ctx, path, requestType, requestBody)
+	}
+
+	if omhc.err != nil {
+		return nil, 0, omhc.err
+	}
+
+	return omhc.HttpClient.DoRawHttpRequestWithObjectMaskWithContext(ctx, path, omhc.mask, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	if omhc.isMaskedCall(requestType, path) {
+		if omhc.err != nil {
+			return nil, 0, omhc.err
+		}
+
+		masks = omhc.mask
+	}
+
+	return omhc.HttpClient.DoRawHttpRequestWithObjectMaskWithContext(ctx, path, masks, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithObjectFilterWithContext(ctx context.Context, path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	if !omhc.isMaskedCall(requestType, path) {
+		return omhc.HttpClient.DoRawHttpRequestWithObjectFilterWithContext(ctx, path, filters, requestType, requestBody)
+	}
+
+	if omhc.err != nil {
+		return nil, 0, omhc.err
+	}
+
+	return omhc.HttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, path, omhc.mask, filters, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx context.Context, path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
+	if omhc.isMaskedCall(requestType, path) {
+		if omhc.err != nil {
+			return nil, 0, omhc.err
+		}
+
+		masks = omhc.mask
+	}
+
+	return omhc.HttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, path, masks, filters, requestType, requestBody)
+}
+
+func (omhc *objectMaskHttpClient) DoRequest(request *softlayer.Request) ([]byte, int, error) {
+	return omhc.DoRequestWithContext(context.Background(), request)
+}
+
+func (omhc *objectMaskHttpClient) DoRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, error) {
+	if omhc.err != nil && omhc.isMaskedCall(request.Verb(), request.Path()) {
+		return nil, 0, omhc.err
+	}
+
+	return omhc.HttpClient.DoRequestWithContext(ctx, omhc.maskedRequest(request))
+}
+
+func (omhc *objectMaskHttpClient) DoPagedRequest(request *softlayer.Request) ([]byte, int, int, error) {
+	return omhc.DoPagedRequestWithContext(context.Background(), request)
+}
+
+func (omhc *objectMaskHttpClient) DoPagedRequestWithContext(ctx context.Context, request *softlayer.Request) ([]byte, int, int, error) {
+	if omhc.err != nil && omhc.isMaskedCall(request.Verb(), request.Path()) {
+		return nil, 0, 0, omhc.err
+	}
+
+	return omhc.HttpClient.DoPagedRequestWithContext(ctx, omhc.maskedRequest(request))
+}
+
+//Private methods
+
+func (omhc *objectMaskHttpClient) maskedRequest(request *softlayer.Request) *softlayer.Request {
+	if !omhc.isMaskedCall(request.Verb(), request.Path()) {
+		return request
+	}
+
+	maskedRequest := *request
+	maskedRequest.Mask = omhc.mask
+
+	return &maskedRequest
+}
+
+// isMaskedCall tells whether a REST call is the GET of getObject, implied by a path without method, or of one of the
+// methods returning the service type
+func (omhc *objectMaskHttpClient) isMaskedCall(requestType string, path string) bool {
+	if !strings.EqualFold(requestType, "GET") {
+		return false
+	}
+
+	path = strings.SplitN(path, "?", 2)[0]
+	segments := strings.Split(strings.TrimSuffix(strings.Trim(path, "/"), ".json"), "/")
+
+	method := segments[len(segments)-1]
+	if _, err := strconv.Atoi(method); err == nil || len(segments) == 1 || method == "getObject" {
+		return true
+	}
+
+	for _, maskedMethod := range omhc.methods {
+		if method == maskedMethod {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	ORDER_STATUS_APPROVED  = "APPROVED"
+	ORDER_STATUS_COMPLETE  = "COMPLETE"
+	ORDER_STATUS_CANCELLED = "CANCELLED"
+)
+
+// OrderTrackOptions configure the polling of an OrderTracker, the zero values use the defaults
+type OrderTrackOptions struct {
+	PollingInterval time.Duration
+	Timeout         time.Duration
+
+	// OnStatus is called after each poll
+	OnStatus func(OrderStatus)
+}
+
+type OrderStatus struct {
+	OrderId int
+	Attempt int
+	Elapsed time.Duration
+
+	// Status is the status of the billing order (e.g. PENDING_APPROVAL, APPROVED or COMPLETE), empty until the order is found
+	Status string
+
+	// ResourceId is the id of the provisioned resource, 0 until its billing item is created
+	ResourceId int
+}
+
+// OrderTracker follows the order of a receipt until it completes or its resource is provisioned, using the billing
+// items of the top level items of the order: their resourceTableId is the id of the provisioned resource
+type OrderTracker struct {
+	client softlayer.Client
+}
+
+func NewOrderTracker(client softlayer.Client) *OrderTracker {
+	return &OrderTracker{
+		client: client,
+	}
+}
+
+// WaitForOrder waits until the order is COMPLETE, e.g. for the upgrade ordered by SoftLayer_Virtual_Guest::AttachEphemeralDisk to land
+func (ot *OrderTracker) WaitForOrder(ctx context.Context, receipt datatypes.SoftLayer_Container_Product_Order_Receipt, opts OrderTrackOptions) (datatypes.SoftLayer_Billing_Order, error) {
+	var order datatypes.SoftLayer_Billing_Order
+
+	err := ot.poll(ctx, receipt, opts, "to complete", func(ctx context.Context, status *OrderStatus) (bool, error) {
+		var err error
+		order, err = ot.getOrder(ctx, receipt.OrderId, status)
+		if err != nil {
+			return false, err
+		}
+
+		return strings.EqualFold(order.Status, ORDER_STATUS_COMPLETE), nil
+	})
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Order{}, err
+	}
+
+	return order, nil
+}
+
+func (ot *OrderTracker) WaitForNetworkStorage(ctx context.Context, receipt datatypes.SoftLayer_Container_Product_Order_Receipt, opts OrderTrackOptions) (datatypes.SoftLayer_Network_Storage, error) {
+	resourceId, err := ot.waitForResourceId(ctx, receipt, opts)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	networkStorageService, err := ot.client.GetSoftLayer_Network_Storage_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	return networkStorageService.GetIscsiVolumeWithContext(ctx, resourceId)
+}
+
+func (ot *OrderTracker) WaitForVirtualGuest(ctx context.Context, receipt datatypes.SoftLayer_Container_Product_Order_Receipt, opts OrderTrackOptions) (datatypes.SoftLayer_Virtual_Guest, error) {
+	resourceId, err := ot.waitForResourceId(ctx, receipt, opts)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	virtualGuestService, err := ot.client.GetSoftLayer_Virtual_Guest_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	return virtualGuestService.GetObjectWithContext(ctx, resourceId)
+}
+
+func (ot *OrderTracker) WaitForHardware(ctx context.Context, receipt datatypes.SoftLayer_Container_Product_Order_Receipt, opts OrderTrackOptions) (datatypes.SoftLayer_Hardware, error) {
+	resourceId, err := ot.waitForResourceId(ctx, receipt, opts)
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	hardwareService, err := ot.client.GetSoftLayer_Hardware_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	return hardwareService.GetObjectWithContext(ctx, resourceId)
+}
+
+//Private methods
+
+func (ot *OrderTracker) poll(ctx context.Context, receipt datatypes.SoftLayer_Container_Product_Order_Receipt, opts OrderTrackOptions, condition string, check func(ctx context.Context, status *OrderStatus) (bool, error)) error {
+	if receipt.OrderId <= 0 {
+		return errors.New(fmt.Sprintf("softlayer-go cannot track the order '%d' of the receipt", receipt.OrderId))
+	}
+
+	return poll(ctx, opts.PollingInterval, opts.Timeout, fmt.Sprintf("order '%d' %s", receipt.OrderId, condition), func(ctx context.Context, attempt int, start time.Time) (bool, error) {
+		status := OrderStatus{
+			OrderId: receipt.OrderId,
+			Attempt: attempt,
+		}
+
+		done, err := check(ctx, &status)
+		if err != nil {
+			return false, err
+		}
+
+		status.Elapsed = time.Since(start)
+		if opts.OnStatus != nil {
+			opts.OnStatus(status)
+		}
+
+		return done, nil
+	})
+}
+
+func (ot *OrderTracker) waitForResourceId(ctx context.Context, receipt datatypes.SoftLayer_Container_Product_Order_Receipt, opts OrderTrackOptions) (int, error) {
+	resourceId := 0
+
+	err := ot.poll(ctx, receipt, opts, "to be provisioned", func(ctx context.Context, status *OrderStatus) (bool, error) {
+		order, err := ot.getOrder(ctx, receipt.OrderId, status)
+		if err != nil {
+			return false, err
+		}
+
+		for _, item := range order.OrderTopLevelItems {
+			if item.BillingItem != nil && item.BillingItem.ResourceTableId > 0 {
+				resourceId = item.BillingItem.ResourceTableId
+				status.ResourceId = resourceId
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resourceId, nil
+}
+
+// getOrder returns the order with the billing items of its top level items, an empty order while it is not found yet
+func (ot *OrderTracker) getOrder(ctx context.Context, orderId int, status *OrderStatus) (datatypes.SoftLayer_Billing_Order, error) {
+	billingOrderService, err := ot.client.GetSoftLayer_Billing_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Order{}, err
+	}
+
+	order, err := billingOrderService.WithMask(
+		"id",
+		"status",
+		"orderTopLevelItems.id",
+		"orderTopLevelItems.categoryCode",
+		"orderTopLevelItems.billingItem.id",
+		"orderTopLevelItems.billingItem.resourceTableId",
+	).GetObjectWithContext(ctx, orderId)
+	if err != nil {
+		if common.IsNotFound(err) {
+			return datatypes.SoftLayer_Billing_Order{}, nil
+		}
+
+		return datatypes.SoftLayer_Billing_Order{}, err
+	}
+
+	status.Status = order.Status
+	if strings.EqualFold(order.Status, ORDER_STATUS_CANCELLED) {
+		return datatypes.SoftLayer_Billing_Order{}, errors.New(fmt.Sprintf("softlayer-go order '%d' was cancelled", orderId))
+	}
+
+	return order, nil
+}
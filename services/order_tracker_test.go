@@ -0,0 +1,190 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
+	softlayerfakes "github.com/maximilien/softlayer-go/softlayer/fakes"
+)
+
+var _ = Describe("OrderTracker", func() {
+	var (
+		fakeClient              *softlayerfakes.FakeClient
+		fakeBillingOrderService *softlayerfakes.FakeSoftLayer_Billing_Order_Service
+
+		orderTracker *services.OrderTracker
+		receipt      datatypes.SoftLayer_Container_Product_Order_Receipt
+		opts         services.OrderTrackOptions
+
+		statuses []services.OrderStatus
+
+		pendingOrder, provisionedOrder datatypes.SoftLayer_Billing_Order
+	)
+
+	BeforeEach(func() {
+		fakeClient = softlayerfakes.NewFakeClient()
+		fakeBillingOrderService = fakeClient.BillingOrderService()
+		fakeBillingOrderService.WithMaskReturns(fakeBillingOrderService)
+
+		orderTracker = services.NewOrderTracker(fakeClient)
+		receipt = datatypes.SoftLayer_Container_Product_Order_Receipt{OrderId: 123}
+
+		statuses = []services.OrderStatus{}
+		opts = services.OrderTrackOptions{
+			PollingInterval: time.Millisecond,
+			Timeout:         time.Second,
+			OnStatus: func(status services.OrderStatus) {
+				statuses = append(statuses, status)
+			},
+		}
+
+		pendingOrder = datatypes.SoftLayer_Billing_Order{
+			Id:     123,
+			Status: "APPROVED",
+			OrderTopLevelItems: []datatypes.SoftLayer_Billing_Order_Item{
+				datatypes.SoftLayer_Billing_Order_Item{Id: 4567},
+			},
+		}
+		provisionedOrder = datatypes.SoftLayer_Billing_Order{
+			Id:     123,
+			Status: "COMPLETE",
+			OrderTopLevelItems: []datatypes.SoftLayer_Billing_Order_Item{
+				datatypes.SoftLayer_Billing_Order_Item{
+					Id:          4567,
+					BillingItem: &datatypes.SoftLayer_Billing_Item{Id: 8910, ResourceTableId: 1234567},
+				},
+			},
+		}
+	})
+
+	Context("#WaitForOrder", func() {
+		It("polls the billing order until it is COMPLETE", func() {
+			fakeBillingOrderService.GetObjectWithContextReturnsOnCall(0, datatypes.SoftLayer_Billing_Order{}, common.NewSoftLayerError("SoftLayer_Billing_Order", "getObject", 404, []byte(`{"error": "fake-error"}`)))
+			fakeBillingOrderService.GetObjectWithContextReturnsOnCall(1, pendingOrder, nil)
+			fakeBillingOrderService.GetObjectWithContextReturnsOnCall(2, provisionedOrder, nil)
+
+			order, err := orderTracker.WaitForOrder(context.Background(), receipt, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order).To(Equal(provisionedOrder))
+
+			Expect(fakeBillingOrderService.GetObjectWithContextCallCount()).To(Equal(3))
+			_, orderId := fakeBillingOrderService.GetObjectWithContextArgsForCall(0)
+			Expect(orderId).To(Equal(123))
+			Expect(fakeBillingOrderService.WithMaskArgsForCall(0)).To(ContainElement("orderTopLevelItems.billingItem.resourceTableId"))
+
+			Expect(statuses).To(HaveLen(3))
+			Expect(statuses[0].OrderId).To(Equal(123))
+			Expect(statuses[0].Status).To(BeEmpty())
+			Expect(statuses[1].Status).To(Equal("APPROVED"))
+			Expect(statuses[2].Attempt).To(Equal(3))
+			Expect(statuses[2].Status).To(Equal("COMPLETE"))
+		})
+
+		It("fails when the order is cancelled", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(datatypes.SoftLayer_Billing_Order{Id: 123, Status: "CANCELLED"}, nil)
+
+			_, err := orderTracker.WaitForOrder(context.Background(), receipt, opts)
+			Expect(err).To(MatchError("softlayer-go order '123' was cancelled"))
+		})
+
+		It("fails when getting the order fails", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(datatypes.SoftLayer_Billing_Order{}, errors.New("fake-error"))
+
+			_, err := orderTracker.WaitForOrder(context.Background(), receipt, opts)
+			Expect(err).To(MatchError("fake-error"))
+		})
+
+		It("fails for a receipt without order", func() {
+			_, err := orderTracker.WaitForOrder(context.Background(), datatypes.SoftLayer_Container_Product_Order_Receipt{}, opts)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeBillingOrderService.GetObjectWithContextCallCount()).To(Equal(0))
+		})
+
+		It("times out when the order does not complete", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(pendingOrder, nil)
+
+			opts.Timeout = 20 * time.Millisecond
+
+			_, err := orderTracker.WaitForOrder(context.Background(), receipt, opts)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timed out after 20ms waiting for order '123' to complete"))
+		})
+
+		It("returns the error of the context when it is canceled", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(pendingOrder, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			opts.OnStatus = func(services.OrderStatus) {
+				cancel()
+			}
+
+			_, err := orderTracker.WaitForOrder(ctx, receipt, opts)
+			Expect(err).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("#WaitForNetworkStorage", func() {
+		It("returns the volume of the billing item of the order", func() {
+			fakeBillingOrderService.GetObjectWithContextReturnsOnCall(0, pendingOrder, nil)
+			fakeBillingOrderService.GetObjectWithContextReturnsOnCall(1, provisionedOrder, nil)
+			fakeClient.NetworkStorageService().GetIscsiVolumeWithContextReturns(datatypes.SoftLayer_Network_Storage{Id: 1234567}, nil)
+
+			volume, err := orderTracker.WaitForNetworkStorage(context.Background(), receipt, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volume.Id).To(Equal(1234567))
+
+			_, volumeId := fakeClient.NetworkStorageService().GetIscsiVolumeWithContextArgsForCall(0)
+			Expect(volumeId).To(Equal(1234567))
+
+			Expect(statuses).To(HaveLen(2))
+			Expect(statuses[0].ResourceId).To(Equal(0))
+			Expect(statuses[1].ResourceId).To(Equal(1234567))
+		})
+
+		It("times out while the billing item is not provisioned", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(pendingOrder, nil)
+
+			opts.Timeout = 20 * time.Millisecond
+
+			_, err := orderTracker.WaitForNetworkStorage(context.Background(), receipt, opts)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("waiting for order '123' to be provisioned"))
+			Expect(fakeClient.NetworkStorageService().GetIscsiVolumeWithContextCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("#WaitForVirtualGuest", func() {
+		It("returns the virtual guest of the billing item of the order", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(provisionedOrder, nil)
+			fakeClient.VirtualGuestService().GetObjectWithContextReturns(datatypes.SoftLayer_Virtual_Guest{Id: 1234567}, nil)
+
+			virtualGuest, err := orderTracker.WaitForVirtualGuest(context.Background(), receipt, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Id).To(Equal(1234567))
+
+			_, virtualGuestId := fakeClient.VirtualGuestService().GetObjectWithContextArgsForCall(0)
+			Expect(virtualGuestId).To(Equal(1234567))
+		})
+	})
+
+	Context("#WaitForHardware", func() {
+		It("returns the hardware of the billing item of the order", func() {
+			fakeBillingOrderService.GetObjectWithContextReturns(provisionedOrder, nil)
+			fakeClient.HardwareService().GetObjectWithContextReturns(datatypes.SoftLayer_Hardware{Id: 1234567}, nil)
+
+			hardware, err := orderTracker.WaitForHardware(context.Background(), receipt, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hardware.Id).To(Equal(1234567))
+
+			_, hardwareId := fakeClient.HardwareService().GetObjectWithContextArgsForCall(0)
+			Expect(hardwareId).To(Equal(1234567))
+		})
+	})
+})
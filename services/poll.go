@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	DEFAULT_WAIT_POLLING_INTERVAL = 10 * time.Second
+	DEFAULT_WAIT_TIMEOUT          = 30 * time.Minute
+)
+
+//Private functions
+
+// poll calls check every pollingInterval until it is done or fails, or until timeout elapses or ctx is done.
+// The zero pollingInterval and timeout are DEFAULT_WAIT_POLLING_INTERVAL and DEFAULT_WAIT_TIMEOUT.
+func poll(ctx context.Context, pollingInterval time.Duration, timeout time.Duration, waitingFor string, check func(ctx context.Context, attempt int, start time.Time) (bool, error)) error {
+	if pollingInterval <= 0 {
+		pollingInterval = DEFAULT_WAIT_POLLING_INTERVAL
+	}
+
+	if timeout <= 0 {
+		timeout = DEFAULT_WAIT_TIMEOUT
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		done, err := check(pollCtx, attempt, start)
+		if err != nil {
+			if pollCtx.Err() != nil {
+				return pollError(ctx, timeout, waitingFor)
+			}
+
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(pollingInterval)
+		select {
+		case <-pollCtx.Done():
+			timer.Stop()
+			return pollError(ctx, timeout, waitingFor)
+		case <-timer.C:
+		}
+	}
+}
+
+func pollError(ctx context.Context, timeout time.Duration, waitingFor string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return errors.New(fmt.Sprintf("softlayer-go timed out after %s waiting for %s", timeout, waitingFor))
+}
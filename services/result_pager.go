@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	common "github.com/maximilien/softlayer-go/common"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const DEFAULT_RESULT_LIMIT = softlayer.DEFAULT_RESULT_LIMIT
+
+// resultPager fetches the results of a SoftLayer list method one page (resultLimit) at a time
+type resultPager struct {
+	client  softlayer.Client
+	request softlayer.Request
+
+	totalItems int
+	done       bool
+	err        error
+}
+
+func newResultPager(client softlayer.Client, request *softlayer.Request) *resultPager {
+	if request.ResultLimit <= 0 {
+		request.ResultLimit = DEFAULT_RESULT_LIMIT
+	}
+
+	return &resultPager{
+		client:  client,
+		request: *request,
+
+		totalItems: -1,
+	}
+}
+
+// TotalItems returns the SoftLayer-Total-Items of the last fetched page, -1 before the first page or when unknown
+func (rp *resultPager) TotalItems() int {
+	return rp.totalItems
+}
+
+func (rp *resultPager) Err() error {
+	return rp.err
+}
+
+//Private methods
+
+func (rp *resultPager) nextPage(ctx context.Context, page interface{}) bool {
+	if rp.done || rp.err != nil {
+		return false
+	}
+
+	response, errorCode, totalItems, err := rp.client.GetHttpClient().DoPagedRequestWithContext(ctx, &rp.request)
+	if err != nil {
+		rp.err = err
+		return false
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		rp.err = common.NewSoftLayerError(rp.request.Service, rp.request.Method, errorCode, response)
+		return false
+	}
+
+	err = json.Unmarshal(response, page)
+	if err != nil {
+		rp.err = err
+		return false
+	}
+
+	if totalItems >= 0 {
+		rp.totalItems = totalItems
+	}
+
+	count := reflect.ValueOf(page).Elem().Len()
+	rp.request.Offset += count
+
+	if count < rp.request.ResultLimit || (rp.totalItems >= 0 && rp.request.Offset >= rp.totalItems) {
+		rp.done = true
+	}
+
+	return count > 0
+}
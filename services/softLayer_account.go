@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,17 +26,28 @@ func (slas *softLayer_Account_Service) GetName() string {
 	return "SoftLayer_Account"
 }
 
+func (slas *softLayer_Account_Service) WithMask(mask ...string) softlayer.SoftLayer_Account_Service {
+	if len(mask) == 0 {
+		return slas
+	}
+
+	return NewSoftLayer_Account_Service(newObjectMaskClient(slas.client, mask, nil))
+}
+
 func (slas *softLayer_Account_Service) GetAccountStatus() (datatypes.SoftLayer_Account_Status, error) {
+	return slas.GetAccountStatusWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetAccountStatusWithContext(ctx context.Context) (datatypes.SoftLayer_Account_Status, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getAccountStatus.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, path, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getAccountStatus, error message '%s'", err.Error())
 		return datatypes.SoftLayer_Account_Status{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getAccountStatus, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Account_Status{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Account_Status{}, common.NewSoftLayerError("SoftLayer_Account", "getAccountStatus", errorCode, responseBytes)
 	}
 
 	accountStatus := datatypes.SoftLayer_Account_Status{}
@@ -50,23 +62,18 @@ func (slas *softLayer_Account_Service) GetAccountStatus() (datatypes.SoftLayer_A
 }
 
 func (slas *softLayer_Account_Service) GetVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualGuests.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualGuests, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
-	}
+	return slas.GetVirtualGuestsWithContext(context.Background())
+}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualGuests, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
-	}
+func (slas *softLayer_Account_Service) GetVirtualGuestsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest, error) {
+	iterator := slas.VirtualGuestIterator("")
 
 	virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
-	err = json.Unmarshal(responseBytes, &virtualGuests)
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
+	for iterator.NextWithContext(ctx) {
+		virtualGuests = append(virtualGuests, iterator.Page()...)
+	}
+
+	if err := iterator.Err(); err != nil {
 		return []datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
@@ -74,6 +81,10 @@ func (slas *softLayer_Account_Service) GetVirtualGuests() ([]datatypes.SoftLayer
 }
 
 func (slas *softLayer_Account_Service) GetVirtualGuestsByFilter(filters string) ([]datatypes.SoftLayer_Virtual_Guest, error) {
+	return slas.GetVirtualGuestsByFilterWithContext(context.Background(), filters)
+}
+
+func (slas *softLayer_Account_Service) GetVirtualGuestsByFilterWithContext(ctx context.Context, filters string) ([]datatypes.SoftLayer_Virtual_Guest, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualGuests.json")
 
 	objectMasks := []string{
@@ -121,15 +132,14 @@ func (slas *softLayer_Account_Service) GetVirtualGuestsByFilter(filters string)
 		"primaryBackendNetworkComponent.networkVlan.id",
 	}
 
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(path, objectMasks, filters, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, path, objectMasks, filters, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualGuests, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualGuests, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Virtual_Guest{}, common.NewSoftLayerError("SoftLayer_Account", "getVirtualGuests", errorCode, responseBytes)
 	}
 
 	virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
@@ -144,30 +154,29 @@ func (slas *softLayer_Account_Service) GetVirtualGuestsByFilter(filters string)
 }
 
 func (slas *softLayer_Account_Service) GetNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getNetworkStorage.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkStorage, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
-	}
+	return slas.GetNetworkStorageWithContext(context.Background())
+}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkStorage, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+func (slas *softLayer_Account_Service) GetNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error) {
+	iterator := slas.NetworkStorageIterator("")
+
+	networkStorages := []datatypes.SoftLayer_Network_Storage{}
+	for iterator.NextWithContext(ctx) {
+		networkStorages = append(networkStorages, iterator.Page()...)
 	}
 
-	networkStorage := []datatypes.SoftLayer_Network_Storage{}
-	err = json.Unmarshal(responseBytes, &networkStorage)
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
+	if err := iterator.Err(); err != nil {
 		return []datatypes.SoftLayer_Network_Storage{}, err
 	}
 
-	return networkStorage, nil
+	return networkStorages, nil
 }
 
 func (slas *softLayer_Account_Service) GetIscsiNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slas.GetIscsiNetworkStorageWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getIscsiNetworkStorage.json")
 
 	objectMasks := []string{
@@ -179,15 +188,14 @@ func (slas *softLayer_Account_Service) GetIscsiNetworkStorage() ([]datatypes.Sof
 		"billingItem.orderItem.order.id",
 	}
 
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectMask(path, objectMasks, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, path, objectMasks, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getIscsiNetworkStorage, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getIscsiNetworkStorage, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Storage{}, common.NewSoftLayerError("SoftLayer_Account", "getIscsiNetworkStorage", errorCode, responseBytes)
 	}
 
 	networkStorage := []datatypes.SoftLayer_Network_Storage{}
@@ -202,6 +210,10 @@ func (slas *softLayer_Account_Service) GetIscsiNetworkStorage() ([]datatypes.Sof
 }
 
 func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithFilter(filter string) ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slas.GetIscsiNetworkStorageWithFilterWithContext(context.Background(), filter)
+}
+
+func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithFilterWithContext(ctx context.Context, filter string) ([]datatypes.SoftLayer_Network_Storage, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getIscsiNetworkStorage.json")
 
 	objectMasks := []string{
@@ -213,15 +225,14 @@ func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithFilter(filter s
 		"billingItem.orderItem.order.id",
 	}
 
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(path, objectMasks, filter, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, path, objectMasks, filter, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getIscsiNetworkStorage, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getIscsiNetworkStorage, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Storage{}, common.NewSoftLayerError("SoftLayer_Account", "getIscsiNetworkStorage", errorCode, responseBytes)
 	}
 
 	networkStorage := []datatypes.SoftLayer_Network_Storage{}
@@ -236,16 +247,19 @@ func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithFilter(filter s
 }
 
 func (slas *softLayer_Account_Service) GetVirtualDiskImages() ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	return slas.GetVirtualDiskImagesWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualDiskImages.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, path, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could get SoftLayer_Account#getVirtualDiskImages, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Virtual_Disk_Image{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualDiskImages, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Disk_Image{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Virtual_Disk_Image{}, common.NewSoftLayerError("SoftLayer_Account", "getVirtualDiskImages", errorCode, responseBytes)
 	}
 
 	virtualDiskImages := []datatypes.SoftLayer_Virtual_Disk_Image{}
@@ -260,6 +274,10 @@ func (slas *softLayer_Account_Service) GetVirtualDiskImages() ([]datatypes.SoftL
 }
 
 func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithFilter(filters string) ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	return slas.GetVirtualDiskImagesWithFilterWithContext(context.Background(), filters)
+}
+
+func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithFilterWithContext(ctx context.Context, filters string) ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
 	isJson, err := common.ValidateJson(filters)
 	if !isJson || err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: filters string %s is not a valid Json formatted string, error message '%s'", filters, err.Error())
@@ -267,15 +285,14 @@ func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithFilter(filters st
 	}
 
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualDiskImages.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilter(path, filters, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterWithContext(ctx, path, filters, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could get SoftLayer_Account#getVirtualDiskImages, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Virtual_Disk_Image{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualDiskImages, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Disk_Image{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Virtual_Disk_Image{}, common.NewSoftLayerError("SoftLayer_Account", "getVirtualDiskImages", errorCode, responseBytes)
 	}
 
 	virtualDiskImages := []datatypes.SoftLayer_Virtual_Disk_Image{}
@@ -290,23 +307,18 @@ func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithFilter(filters st
 }
 
 func (slas *softLayer_Account_Service) GetSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getSshKeys.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSshKeys, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
-	}
+	return slas.GetSshKeysWithContext(context.Background())
+}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSshKeys, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
-	}
+func (slas *softLayer_Account_Service) GetSshKeysWithContext(ctx context.Context) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	iterator := slas.SshKeyIterator("")
 
 	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
-	err = json.Unmarshal(responseBytes, &sshKeys)
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
+	for iterator.NextWithContext(ctx) {
+		sshKeys = append(sshKeys, iterator.Page()...)
+	}
+
+	if err := iterator.Err(); err != nil {
 		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
@@ -314,30 +326,29 @@ func (slas *softLayer_Account_Service) GetSshKeys() ([]datatypes.SoftLayer_Secur
 }
 
 func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroups() ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getBlockDeviceTemplateGroups.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getBlockDeviceTemplateGroups, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
-	}
+	return slas.GetBlockDeviceTemplateGroupsWithContext(context.Background())
+}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getBlockDeviceTemplateGroups, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
+func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	iterator := slas.BlockDeviceTemplateGroupIterator("")
+
+	vgbdtgs := []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
+	for iterator.NextWithContext(ctx) {
+		vgbdtgs = append(vgbdtgs, iterator.Page()...)
 	}
 
-	vgbdtGroups := []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
-	err = json.Unmarshal(responseBytes, &vgbdtGroups)
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
+	if err := iterator.Err(); err != nil {
 		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
-	return vgbdtGroups, nil
+	return vgbdtgs, nil
 }
 
 func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithFilter(filters string) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	return slas.GetBlockDeviceTemplateGroupsWithFilterWithContext(context.Background(), filters)
+}
+
+func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithFilterWithContext(ctx context.Context, filters string) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
 	isJson, err := common.ValidateJson(filters)
 	if !isJson || err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: filters string %s is not a valid Json formatted string, error message '%s'", filters, err.Error())
@@ -345,15 +356,14 @@ func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithFilter(fi
 	}
 
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getBlockDeviceTemplateGroups.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilter(path, filters, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterWithContext(ctx, path, filters, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getBlockDeviceTemplateGroups, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getBlockDeviceTemplateGroups, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, common.NewSoftLayerError("SoftLayer_Account", "getBlockDeviceTemplateGroups", errorCode, responseBytes)
 	}
 
 	vgbdtGroups := []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
@@ -367,30 +377,28 @@ func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithFilter(fi
 	return vgbdtGroups, nil
 }
 
-//TODO: why is this method empty? Remove?
+// TODO: why is this method empty? Remove?
 func (slas *softLayer_Account_Service) GetDatacentersWithSubnetAllocations() ([]datatypes.SoftLayer_Location, error) {
+	return slas.GetDatacentersWithSubnetAllocationsWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetDatacentersWithSubnetAllocationsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Location, error) {
 	return []datatypes.SoftLayer_Location{}, nil
 }
 
 func (slas *softLayer_Account_Service) GetHardware() ([]datatypes.SoftLayer_Hardware, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getHardware.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getHardware, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
-	}
+	return slas.GetHardwareWithContext(context.Background())
+}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getHardware, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
-	}
+func (slas *softLayer_Account_Service) GetHardwareWithContext(ctx context.Context) ([]datatypes.SoftLayer_Hardware, error) {
+	iterator := slas.HardwareIterator("")
 
 	hardwares := []datatypes.SoftLayer_Hardware{}
-	err = json.Unmarshal(responseBytes, &hardwares)
+	for iterator.NextWithContext(ctx) {
+		hardwares = append(hardwares, iterator.Page()...)
+	}
 
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
+	if err := iterator.Err(); err != nil {
 		return []datatypes.SoftLayer_Hardware{}, err
 	}
 
@@ -398,16 +406,19 @@ func (slas *softLayer_Account_Service) GetHardware() ([]datatypes.SoftLayer_Hard
 }
 
 func (slas *softLayer_Account_Service) GetDnsDomains() ([]datatypes.SoftLayer_Dns_Domain, error) {
+	return slas.GetDnsDomainsWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetDnsDomainsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Dns_Domain, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getDomains.json")
-	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, path, "GET", &bytes.Buffer{})
 	if err != nil {
 		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getDomains, error message '%s'", err.Error())
 		return []datatypes.SoftLayer_Dns_Domain{}, errors.New(errorMessage)
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getDomains, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Dns_Domain{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Dns_Domain{}, common.NewSoftLayerError("SoftLayer_Account", "getDomains", errorCode, responseBytes)
 	}
 
 	domains := []datatypes.SoftLayer_Dns_Domain{}
@@ -420,3 +431,28 @@ func (slas *softLayer_Account_Service) GetDnsDomains() ([]datatypes.SoftLayer_Dn
 
 	return domains, nil
 }
+
+func (slas *softLayer_Account_Service) VirtualGuestIterator(filters string) softlayer.SoftLayer_Virtual_Guest_Iterator {
+	request := softlayer.NewRequest(slas.GetName(), "getVirtualGuests").WithFilter(filters)
+	return &virtualGuestIterator{resultPager: newResultPager(slas.client, request)}
+}
+
+func (slas *softLayer_Account_Service) NetworkStorageIterator(filters string) softlayer.SoftLayer_Network_Storage_Iterator {
+	request := softlayer.NewRequest(slas.GetName(), "getNetworkStorage").WithFilter(filters)
+	return &networkStorageIterator{resultPager: newResultPager(slas.client, request)}
+}
+
+func (slas *softLayer_Account_Service) SshKeyIterator(filters string) softlayer.SoftLayer_Security_Ssh_Key_Iterator {
+	request := softlayer.NewRequest(slas.GetName(), "getSshKeys").WithFilter(filters)
+	return &sshKeyIterator{resultPager: newResultPager(slas.client, request)}
+}
+
+func (slas *softLayer_Account_Service) BlockDeviceTemplateGroupIterator(filters string) softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Iterator {
+	request := softlayer.NewRequest(slas.GetName(), "getBlockDeviceTemplateGroups").WithFilter(filters)
+	return &blockDeviceTemplateGroupIterator{resultPager: newResultPager(slas.client, request)}
+}
+
+func (slas *softLayer_Account_Service) HardwareIterator(filters string) softlayer.SoftLayer_Hardware_Iterator {
+	request := softlayer.NewRequest(slas.GetName(), "getHardware").WithFilter(filters)
+	return &hardwareIterator{resultPager: newResultPager(slas.client, request)}
+}
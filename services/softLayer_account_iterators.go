@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type virtualGuestIterator struct {
+	*resultPager
+
+	page []datatypes.SoftLayer_Virtual_Guest
+}
+
+func (it *virtualGuestIterator) Next() bool {
+	return it.NextWithContext(context.Background())
+}
+
+func (it *virtualGuestIterator) NextWithContext(ctx context.Context) bool {
+	it.page = []datatypes.SoftLayer_Virtual_Guest{}
+	return it.nextPage(ctx, &it.page)
+}
+
+func (it *virtualGuestIterator) Page() []datatypes.SoftLayer_Virtual_Guest {
+	return it.page
+}
+
+type networkStorageIterator struct {
+	*resultPager
+
+	page []datatypes.SoftLayer_Network_Storage
+}
+
+func (it *networkStorageIterator) Next() bool {
+	return it.NextWithContext(context.Background())
+}
+
+func (it *networkStorageIterator) NextWithContext(ctx context.Context) bool {
+	it.page = []datatypes.SoftLayer_Network_Storage{}
+	return it.nextPage(ctx, &it.page)
+}
+
+func (it *networkStorageIterator) Page() []datatypes.SoftLayer_Network_Storage {
+	return it.page
+}
+
+type hardwareIterator struct {
+	*resultPager
+
+	page []datatypes.SoftLayer_Hardware
+}
+
+func (it *hardwareIterator) Next() bool {
+	return it.NextWithContext(context.Background())
+}
+
+func (it *hardwareIterator) NextWithContext(ctx context.Context) bool {
+	it.page = []datatypes.SoftLayer_Hardware{}
+	return it.nextPage(ctx, &it.page)
+}
+
+func (it *hardwareIterator) Page() []datatypes.SoftLayer_Hardware {
+	return it.page
+}
+
+type sshKeyIterator struct {
+	*resultPager
+
+	page []datatypes.SoftLayer_Security_Ssh_Key
+}
+
+func (it *sshKeyIterator) Next() bool {
+	return it.NextWithContext(context.Background())
+}
+
+func (it *sshKeyIterator) NextWithContext(ctx context.Context) bool {
+	it.page = []datatypes.SoftLayer_Security_Ssh_Key{}
+	return it.nextPage(ctx, &it.page)
+}
+
+func (it *sshKeyIterator) Page() []datatypes.SoftLayer_Security_Ssh_Key {
+	return it.page
+}
+
+type blockDeviceTemplateGroupIterator struct {
+	*resultPager
+
+	page []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group
+}
+
+func (it *blockDeviceTemplateGroupIterator) Next() bool {
+	return it.NextWithContext(context.Background())
+}
+
+func (it *blockDeviceTemplateGroupIterator) NextWithContext(ctx context.Context) bool {
+	it.page = []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
+	return it.nextPage(ctx, &it.page)
+}
+
+func (it *blockDeviceTemplateGroupIterator) Page() []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group {
+	return it.page
+}
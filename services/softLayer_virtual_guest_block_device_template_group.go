@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -28,15 +29,26 @@ func (slvgs *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetNam
 	return "SoftLayer_Virtual_Guest_Block_Device_Template_Group"
 }
 
+func (slvgs *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) WithMask(mask ...string) softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service {
+	if len(mask) == 0 {
+		return slvgs
+	}
+
+	return NewSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service(newObjectMaskClient(slvgs.client, mask, datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}))
+}
+
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetObject(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetObjectWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getObject", errorCode, response)
 	}
 
 	vgbdtGroup := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
@@ -49,14 +61,17 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) DeleteObject(id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slvgbdtg.GetName(), id), "DELETE", new(bytes.Buffer))
+	return slvgbdtg.DeleteObjectWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) DeleteObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d.json", slvgbdtg.GetName(), id), "DELETE", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#deleteObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "deleteObject", errorCode, response)
 	}
 
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -69,14 +84,17 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Del
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetDatacenters(id int) ([]datatypes.SoftLayer_Location, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getDatacenters.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetDatacentersWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetDatacentersWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getDatacenters.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Location{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getDatacenters, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Location{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Location{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getDatacenters", errorCode, response)
 	}
 
 	locations := []datatypes.SoftLayer_Location{}
@@ -89,14 +107,17 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetSshKeys(id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getSshKeys.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetSshKeysWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetSshKeysWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getSshKeys.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getSshKeys, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Security_Ssh_Key{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getSshKeys", errorCode, response)
 	}
 
 	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
@@ -109,14 +130,17 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStatus(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getStatus.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetStatusWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStatusWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getStatus.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getStatus, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getStatus", errorCode, response)
 	}
 
 	status := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}
@@ -129,14 +153,17 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageType(id int) (datatypes.SoftLayer_Image_Type, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getImageType.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetImageTypeWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageTypeWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Image_Type, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getImageType.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Image_Type{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getImageType, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Image_Type{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Image_Type{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getImageType", errorCode, response)
 	}
 
 	imageType := datatypes.SoftLayer_Image_Type{}
@@ -149,14 +176,17 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStorageLocations(id int) ([]datatypes.SoftLayer_Location, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getStorageLocations.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetStorageLocationsWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStorageLocationsWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getStorageLocations.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Location{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getStorageLocations, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Location{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Location{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getStorageLocations", errorCode, response)
 	}
 
 	locations := []datatypes.SoftLayer_Location{}
@@ -169,6 +199,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CreateFromExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	return slvgbdtg.CreateFromExternalSourceWithContext(context.Background(), configuration)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CreateFromExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
 	parameters := datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration{configuration},
 	}
@@ -178,14 +212,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cre
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/createFromExternalSource.json", slvgbdtg.GetName()), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/createFromExternalSource.json", slvgbdtg.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#createFromExternalSource, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "createFromExternalSource", errorCode, response)
 	}
 
 	vgbdtGroup := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
@@ -198,6 +231,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cre
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CopyToExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error) {
+	return slvgbdtg.CopyToExternalSourceWithContext(context.Background(), configuration)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CopyToExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error) {
 	parameters := datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration{configuration},
 	}
@@ -207,14 +244,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cop
 		return false, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/copyToExternalSource.json", slvgbdtg.GetName()), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/copyToExternalSource.json", slvgbdtg.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#copyToExternalSource, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "copyToExternalSource", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -225,25 +261,31 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cop
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageTypeKeyName(id int) (string, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getImageTypeKeyName.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetImageTypeKeyNameWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageTypeKeyNameWithContext(ctx context.Context, id int) (string, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getImageTypeKeyName.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getImageTypeKeyName, HTTP error code: '%d'", errorCode)
-		return "", errors.New(errorMessage)
+		return "", common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getImageTypeKeyName", errorCode, response)
 	}
 
 	return string(response), err
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetTransaction(id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getTransaction.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetTransactionWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetTransactionWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getTransaction.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getTransaction, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getTransaction", errorCode, response)
 	}
 
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -256,6 +298,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) DenySharingAccess(id int, accountId int) (bool, error) {
+	return slvgbdtg.DenySharingAccessWithContext(context.Background(), id, accountId)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) DenySharingAccessWithContext(ctx context.Context, id int, accountId int) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_GroupInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_GroupInitParameter{
 			AccountId: accountId,
@@ -267,14 +313,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Den
 		return false, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/denySharingAccess.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/denySharingAccess.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#denySharingAccess, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "denySharingAccess", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -285,6 +330,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Den
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) PermitSharingAccess(id int, accountId int) (bool, error) {
+	return slvgbdtg.PermitSharingAccessWithContext(context.Background(), id, accountId)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) PermitSharingAccessWithContext(ctx context.Context, id int, accountId int) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_GroupInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_GroupInitParameter{
 			AccountId: accountId,
@@ -296,14 +345,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Per
 		return false, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/permitSharingAccess.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/permitSharingAccess.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#permitSharingAccess, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "permitSharingAccess", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -314,6 +362,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Per
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) AddLocations(id int, locations []datatypes.SoftLayer_Location) (bool, error) {
+	return slvgbdtg.AddLocationsWithContext(context.Background(), id, locations)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) AddLocationsWithContext(ctx context.Context, id int, locations []datatypes.SoftLayer_Location) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_LocationsInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_LocationsInitParameter{
 			Locations: locations,
@@ -325,14 +377,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Add
 		return false, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/addLocations.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/addLocations.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#addLocations, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "addLocations", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -343,6 +394,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Add
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) RemoveLocations(id int, locations []datatypes.SoftLayer_Location) (bool, error) {
+	return slvgbdtg.RemoveLocationsWithContext(context.Background(), id, locations)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) RemoveLocationsWithContext(ctx context.Context, id int, locations []datatypes.SoftLayer_Location) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_LocationsInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_LocationsInitParameter{
 			Locations: locations,
@@ -354,14 +409,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Rem
 		return false, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/removeLocations.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/removeLocations.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#removeLocations, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "removeLocations", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -372,6 +426,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Rem
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) SetAvailableLocations(id int, locations []datatypes.SoftLayer_Location) (bool, error) {
+	return slvgbdtg.SetAvailableLocationsWithContext(context.Background(), id, locations)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) SetAvailableLocationsWithContext(ctx context.Context, id int, locations []datatypes.SoftLayer_Location) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_LocationsInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_LocationsInitParameter{
 			Locations: locations,
@@ -383,14 +441,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Set
 		return false, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/setAvailableLocations.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/setAvailableLocations.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#setAvailableLocations, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "setAvailableLocations", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -401,6 +458,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Set
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CreatePublicArchiveTransaction(id int, groupName string, summary string, note string, locations []datatypes.SoftLayer_Location) (int, error) {
+	return slvgbdtg.CreatePublicArchiveTransactionWithContext(context.Background(), id, groupName, summary, note, locations)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CreatePublicArchiveTransactionWithContext(ctx context.Context, id int, groupName string, summary string, note string, locations []datatypes.SoftLayer_Location) (int, error) {
 	groupName = url.QueryEscape(groupName)
 	summary = url.QueryEscape(summary)
 	note = url.QueryEscape(note)
@@ -414,14 +475,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cre
 		return 0, err
 	}
 
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/createPublicArchiveTransaction.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/createPublicArchiveTransaction.json", slvgbdtg.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return 0, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#createPublicArchiveTransaction, HTTP error code: '%d'", errorCode)
-		return 0, errors.New(errorMessage)
+		return 0, common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "createPublicArchiveTransaction", errorCode, response)
 	}
 
 	transactionId, err := strconv.Atoi(string(response[:]))
@@ -433,11 +493,14 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cre
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetGlobalIdentifier(id int) (string, error) {
-	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getGlobalIdentifier.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetGlobalIdentifierWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetGlobalIdentifierWithContext(ctx context.Context, id int) (string, error) {
+	response, errorCode, err := slvgbdtg.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getGlobalIdentifier.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest_Block_Device_Template_Group#getGlobalIdentifier, HTTP error code: '%d'", errorCode)
-		return "", errors.New(errorMessage)
+		return "", common.NewSoftLayerError("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getGlobalIdentifier", errorCode, response)
 	}
 
 	return string(strings.TrimSpace(string(response))), err
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
 )
@@ -365,6 +366,72 @@ var _ = Describe("SoftLayer_Account_Service", func() {
 
 	})
 
+	Context("#GetBlockDeviceTemplateGroups pagination", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getBlockDeviceTemplateGroups.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fetches all the pages", func() {
+			groups, err := accountService.GetBlockDeviceTemplateGroups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(groups)).To(Equal(535))
+
+			requests := fakeClient.FakeHttpClient.DoPagedRequestRequests
+			Expect(len(requests)).To(Equal(6))
+			for i, request := range requests {
+				Expect(request.Path()).To(Equal("SoftLayer_Account/getBlockDeviceTemplateGroups.json"))
+				Expect(request.ResultLimit).To(Equal(services.DEFAULT_RESULT_LIMIT))
+				Expect(request.Offset).To(Equal(i * services.DEFAULT_RESULT_LIMIT))
+			}
+		})
+	})
+
+	Context("#BlockDeviceTemplateGroupIterator", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getBlockDeviceTemplateGroups.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("yields pages until SoftLayer-Total-Items is reached", func() {
+			iterator := accountService.BlockDeviceTemplateGroupIterator(`{"blockDeviceTemplateGroups":{"name":{"operation":"foo"}}}`)
+			Expect(iterator.TotalItems()).To(Equal(-1))
+
+			pageSizes := []int{}
+			for iterator.Next() {
+				pageSizes = append(pageSizes, len(iterator.Page()))
+				Expect(iterator.TotalItems()).To(Equal(535))
+			}
+
+			Expect(iterator.Err()).ToNot(HaveOccurred())
+			Expect(pageSizes).To(Equal([]int{100, 100, 100, 100, 100, 35}))
+			Expect(fakeClient.FakeHttpClient.DoRequestRequest.Filter).To(Equal(`{"blockDeviceTemplateGroups":{"name":{"operation":"foo"}}}`))
+		})
+
+		It("stops and reports the error when the HTTP client returns an error code", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 500
+
+			iterator := accountService.BlockDeviceTemplateGroupIterator("")
+			Expect(iterator.Next()).To(BeFalse())
+			Expect(iterator.Err()).To(HaveOccurred())
+			Expect(iterator.Next()).To(BeFalse())
+		})
+	})
+
+	Context("#VirtualGuestIterator", func() {
+		It("stops when a page is smaller than the result limit", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`[{"id":1},{"id":2}]`)
+			fakeClient.FakeHttpClient.DoPagedRequestTotalItems = 1000
+
+			iterator := accountService.VirtualGuestIterator("")
+			Expect(iterator.Next()).To(BeTrue())
+			Expect(len(iterator.Page())).To(Equal(2))
+			Expect(iterator.TotalItems()).To(Equal(1000))
+			Expect(iterator.Next()).To(BeFalse())
+			Expect(iterator.Err()).ToNot(HaveOccurred())
+		})
+	})
+
 	Context("#GetBlockDeviceTemplateGroups", func() {
 		BeforeEach(func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getBlockDeviceTemplateGroups.json")
@@ -2,7 +2,7 @@ package services
 
 import (
 	"bytes"
-	"errors"
+	"context"
 	"fmt"
 	common "github.com/maximilien/softlayer-go/common"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
@@ -22,19 +22,30 @@ func (slbi *softLayer_Billing_Item_Service) GetName() string {
 	return "SoftLayer_Billing_Item"
 }
 
+func (slbi *softLayer_Billing_Item_Service) WithMask(mask ...string) softlayer.SoftLayer_Billing_Item_Service {
+	if len(mask) == 0 {
+		return slbi
+	}
+
+	return NewSoftLayer_Billing_Item_Service(newObjectMaskClient(slbi.client, mask, nil))
+}
+
 func (slbi *softLayer_Billing_Item_Service) CancelService(billingId int) (bool, error) {
-	response, errorCode, err := slbi.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/cancelService.json", slbi.GetName(), billingId), "GET", new(bytes.Buffer))
+	return slbi.CancelServiceWithContext(context.Background(), billingId)
+}
+
+func (slbi *softLayer_Billing_Item_Service) CancelServiceWithContext(ctx context.Context, billingId int) (bool, error) {
+	response, errorCode, err := slbi.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/cancelService.json", slbi.GetName(), billingId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, nil
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Billing_Item", "cancelService", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item#CancelService, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, nil
 	}
 
 	return true, err
@@ -2,8 +2,8 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	common "github.com/maximilien/softlayer-go/common"
@@ -25,7 +25,19 @@ func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) GetName() str
 	return "SoftLayer_Billing_Item_Cancellation_Request"
 }
 
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) WithMask(mask ...string) softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service {
+	if len(mask) == 0 {
+		return slbicr
+	}
+
+	return NewSoftLayer_Billing_Item_Cancellation_Request_Service(newObjectMaskClient(slbicr.client, mask, datatypes.SoftLayer_Billing_Item_Cancellation_Request{}))
+}
+
 func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObject(request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	return slbicr.CreateObjectWithContext(context.Background(), request)
+}
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObjectWithContext(ctx context.Context, request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
 	parameters := datatypes.SoftLayer_Billing_Item_Cancellation_Request_Parameters{
 		Parameters: []datatypes.SoftLayer_Billing_Item_Cancellation_Request{
 			request,
@@ -37,14 +49,13 @@ func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObject(
 		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
 	}
 
-	responseBytes, errorCode, err := slbicr.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/createObject.json", slbicr.GetName()), "POST", bytes.NewBuffer(requestBody))
+	responseBytes, errorCode, err := slbicr.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/createObject.json", slbicr.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item_Cancellation_Request#createObject TTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, common.NewSoftLayerError("SoftLayer_Billing_Item_Cancellation_Request", "createObject", errorCode, responseBytes)
 	}
 
 	result := datatypes.SoftLayer_Billing_Item_Cancellation_Request{}
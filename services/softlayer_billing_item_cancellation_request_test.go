@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"fmt"
 	"os"
 
 	. "github.com/onsi/ginkgo"
@@ -70,6 +71,7 @@ var _ = Describe("SoftLayer_Billing_Item_Cancellation_Request", func() {
 
 					_, err := billingItemCancellationRequestService.CreateObject(request)
 					Expect(err).To(HaveOccurred())
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item_Cancellation_Request#createObject, HTTP error code: '%d'", errorCode)))
 				}
 			})
 
@@ -80,6 +82,7 @@ var _ = Describe("SoftLayer_Billing_Item_Cancellation_Request", func() {
 
 					_, err := billingItemCancellationRequestService.CreateObject(request)
 					Expect(err).To(HaveOccurred())
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item_Cancellation_Request#createObject, HTTP error code: '%d'", errorCode)))
 				}
 			})
 		})
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,7 +26,19 @@ func (sldds *softLayer_Dns_Domain_Service) GetName() string {
 	return "SoftLayer_Dns_Domain"
 }
 
+func (sldds *softLayer_Dns_Domain_Service) WithMask(mask ...string) softlayer.SoftLayer_Dns_Domain_Service {
+	if len(mask) == 0 {
+		return sldds
+	}
+
+	return NewSoftLayer_Dns_Domain_Service(newObjectMaskClient(sldds.client, mask, datatypes.SoftLayer_Dns_Domain{}))
+}
+
 func (sldds *softLayer_Dns_Domain_Service) CreateObject(template datatypes.SoftLayer_Dns_Domain_Template) (datatypes.SoftLayer_Dns_Domain, error) {
+	return sldds.CreateObjectWithContext(context.Background(), template)
+}
+
+func (sldds *softLayer_Dns_Domain_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Dns_Domain_Template) (datatypes.SoftLayer_Dns_Domain, error) {
 	if template.ResourceRecords == nil {
 		template.ResourceRecords = []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
 	}
@@ -41,19 +54,18 @@ func (sldds *softLayer_Dns_Domain_Service) CreateObject(template datatypes.SoftL
 		return datatypes.SoftLayer_Dns_Domain{}, err
 	}
 
-	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s.json", sldds.GetName()), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s.json", sldds.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Dns_Domain{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain#createObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Dns_Domain{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Dns_Domain{}, common.NewSoftLayerError("SoftLayer_Dns_Domain", "createObject", errorCode, response)
 	}
 
 	err = sldds.client.GetHttpClient().CheckForHttpResponseErrors(response)
 	if err != nil {
-		return datatypes.SoftLayer_Dns_Domain{}, err
+		return datatypes.SoftLayer_Dns_Domain{}, common.WithService(err, "SoftLayer_Dns_Domain", "createObject")
 	}
 
 	softLayer_Dns_Domain := datatypes.SoftLayer_Dns_Domain{}
@@ -66,6 +78,10 @@ func (sldds *softLayer_Dns_Domain_Service) CreateObject(template datatypes.SoftL
 }
 
 func (sldds *softLayer_Dns_Domain_Service) GetObject(dnsId int) (datatypes.SoftLayer_Dns_Domain, error) {
+	return sldds.GetObjectWithContext(context.Background(), dnsId)
+}
+
+func (sldds *softLayer_Dns_Domain_Service) GetObjectWithContext(ctx context.Context, dnsId int) (datatypes.SoftLayer_Dns_Domain, error) {
 	objectMask := []string{
 		"id",
 		"name",
@@ -78,14 +94,13 @@ func (sldds *softLayer_Dns_Domain_Service) GetObject(dnsId int) (datatypes.SoftL
 		"secondary",
 	}
 
-	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", sldds.GetName(), dnsId), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", sldds.GetName(), dnsId), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Dns_Domain{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Dns_Domain{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Dns_Domain{}, common.NewSoftLayerError("SoftLayer_Dns_Domain", "getObject", errorCode, response)
 	}
 
 	dns_domain := datatypes.SoftLayer_Dns_Domain{}
@@ -98,15 +113,18 @@ func (sldds *softLayer_Dns_Domain_Service) GetObject(dnsId int) (datatypes.SoftL
 }
 
 func (sldds *softLayer_Dns_Domain_Service) DeleteObject(dnsId int) (bool, error) {
-	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", sldds.GetName(), dnsId), "DELETE", new(bytes.Buffer))
+	return sldds.DeleteObjectWithContext(context.Background(), dnsId)
+}
 
-	if response_value := string(response[:]); response_value != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to delete dns domain with id '%d', got '%s' as response from the API", dnsId, response_value))
-	}
+func (sldds *softLayer_Dns_Domain_Service) DeleteObjectWithContext(ctx context.Context, dnsId int) (bool, error) {
+	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d.json", sldds.GetName(), dnsId), "DELETE", new(bytes.Buffer))
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain#deleteObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Dns_Domain", "deleteObject", errorCode, response)
+	}
+
+	if response_value := string(response[:]); response_value != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete dns domain with id '%d', got '%s' as response from the API", dnsId, response_value))
 	}
 
 	return true, err
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,7 +26,19 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) GetName() string {
 	return "SoftLayer_Dns_Domain_ResourceRecord"
 }
 
+func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) WithMask(mask ...string) softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service {
+	if len(mask) == 0 {
+		return sldr
+	}
+
+	return NewSoftLayer_Dns_Domain_ResourceRecord_Service(newObjectMaskClient(sldr.client, mask, datatypes.SoftLayer_Dns_Domain_ResourceRecord{}))
+}
+
 func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) CreateObject(template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	return sldr.CreateObjectWithContext(context.Background(), template)
+}
+
+func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
 	parameters := datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template_Parameters{
 		Parameters: []datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{
 			template,
@@ -37,19 +50,18 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) CreateObject(template d
 		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
 	}
 
-	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/createObject", sldr.getNameByType(template.Type)), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/createObject", sldr.getNameByType(template.Type)), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain_ResourceRecord#createObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, common.NewSoftLayerError("SoftLayer_Dns_Domain_ResourceRecord", "createObject", errorCode, response)
 	}
 
 	err = sldr.client.GetHttpClient().CheckForHttpResponseErrors(response)
 	if err != nil {
-		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, common.WithService(err, "SoftLayer_Dns_Domain_ResourceRecord", "createObject")
 	}
 
 	dns_record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
@@ -62,6 +74,10 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) CreateObject(template d
 }
 
 func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) GetObject(id int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	return sldr.GetObjectWithContext(context.Background(), id)
+}
+
+func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
 	objectMask := []string{
 		"data",
 		"domainId",
@@ -82,19 +98,18 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) GetObject(id int) (data
 		"weight",
 	}
 
-	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", sldr.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", sldr.GetName(), id), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
 	}
 
-	err = sldr.client.GetHttpClient().CheckForHttpResponseErrors(response)
-	if err != nil {
-		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	if common.IsHttpErrorCode(errorCode) {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, common.NewSoftLayerError("SoftLayer_Dns_Domain_ResourceRecord", "getObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain_ResourceRecord#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(errorMessage)
+	err = sldr.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, common.WithService(err, "SoftLayer_Dns_Domain_ResourceRecord", "getObject")
 	}
 
 	dns_record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
@@ -107,21 +122,28 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) GetObject(id int) (data
 }
 
 func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) DeleteObject(recordId int) (bool, error) {
-	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", sldr.GetName(), recordId), "DELETE", new(bytes.Buffer))
+	return sldr.DeleteObjectWithContext(context.Background(), recordId)
+}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to delete DNS Domain Record with id '%d', got '%s' as response from the API.", recordId, res))
-	}
+func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) DeleteObjectWithContext(ctx context.Context, recordId int) (bool, error) {
+	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d.json", sldr.GetName(), recordId), "DELETE", new(bytes.Buffer))
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain_ResourceRecord#deleteObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Dns_Domain_ResourceRecord", "deleteObject", errorCode, response)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete DNS Domain Record with id '%d', got '%s' as response from the API.", recordId, res))
 	}
 
 	return true, err
 }
 
 func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) EditObject(recordId int, template datatypes.SoftLayer_Dns_Domain_ResourceRecord) (bool, error) {
+	return sldr.EditObjectWithContext(context.Background(), recordId, template)
+}
+
+func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) EditObjectWithContext(ctx context.Context, recordId int, template datatypes.SoftLayer_Dns_Domain_ResourceRecord) (bool, error) {
 	parameters := datatypes.SoftLayer_Dns_Domain_ResourceRecord_Parameters{
 		Parameters: []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
 			template,
@@ -133,15 +155,14 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) EditObject(recordId int
 		return false, err
 	}
 
-	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", sldr.getNameByType(template.Type), recordId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := sldr.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/editObject.json", sldr.getNameByType(template.Type), recordId), "POST", bytes.NewBuffer(requestBody))
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to edit DNS Domain Record with id: %d, got '%s' as response from the API.", recordId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Dns_Domain_ResourceRecord", "editObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain_ResourceRecord#editObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit DNS Domain Record with id: %d, got '%s' as response from the API.", recordId, res))
 	}
 
 	return true, err
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,7 +26,19 @@ func (slhs *softLayer_Hardware_Service) GetName() string {
 	return "SoftLayer_Hardware"
 }
 
+func (slhs *softLayer_Hardware_Service) WithMask(mask ...string) softlayer.SoftLayer_Hardware_Service {
+	if len(mask) == 0 {
+		return slhs
+	}
+
+	return NewSoftLayer_Hardware_Service(newObjectMaskClient(slhs.client, mask, datatypes.SoftLayer_Hardware{}))
+}
+
 func (slhs *softLayer_Hardware_Service) AllowAccessToNetworkStorage(id int, storage datatypes.SoftLayer_Network_Storage) (bool, error) {
+	return slhs.AllowAccessToNetworkStorageWithContext(context.Background(), id, storage)
+}
+
+func (slhs *softLayer_Hardware_Service) AllowAccessToNetworkStorageWithContext(ctx context.Context, id int, storage datatypes.SoftLayer_Network_Storage) (bool, error) {
 	parameters := datatypes.SoftLayer_Hardware_NetworkStorage_Parameters{
 		Parameters: storage,
 	}
@@ -35,14 +48,13 @@ func (slhs *softLayer_Hardware_Service) AllowAccessToNetworkStorage(id int, stor
 		return false, err
 	}
 
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/allowAccessToNetworkStorage.json", slhs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/allowAccessToNetworkStorage.json", slhs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#allowAccessToNetworkStorage, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "allowAccessToNetworkStorage", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -53,6 +65,10 @@ func (slhs *softLayer_Hardware_Service) AllowAccessToNetworkStorage(id int, stor
 }
 
 func (slhs *softLayer_Hardware_Service) CreateObject(template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error) {
+	return slhs.CreateObjectWithContext(context.Background(), template)
+}
+
+func (slhs *softLayer_Hardware_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error) {
 	parameters := datatypes.SoftLayer_Hardware_Template_Parameters{
 		Parameters: []datatypes.SoftLayer_Hardware_Template{
 			template,
@@ -64,19 +80,18 @@ func (slhs *softLayer_Hardware_Service) CreateObject(template datatypes.SoftLaye
 		return datatypes.SoftLayer_Hardware{}, err
 	}
 
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s.json", slhs.GetName()), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s.json", slhs.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Hardware{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#createObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Hardware{}, common.NewSoftLayerError("SoftLayer_Hardware", "createObject", errorCode, response)
 	}
 
 	err = slhs.client.GetHttpClient().CheckForHttpResponseErrors(response)
 	if err != nil {
-		return datatypes.SoftLayer_Hardware{}, err
+		return datatypes.SoftLayer_Hardware{}, common.WithService(err, "SoftLayer_Hardware", "createObject")
 	}
 
 	hardware := datatypes.SoftLayer_Hardware{}
@@ -89,6 +104,10 @@ func (slhs *softLayer_Hardware_Service) CreateObject(template datatypes.SoftLaye
 }
 
 func (slhs *softLayer_Hardware_Service) FindByIpAddress(ipAddress string) (datatypes.SoftLayer_Hardware, error) {
+	return slhs.FindByIpAddressWithContext(context.Background(), ipAddress)
+}
+
+func (slhs *softLayer_Hardware_Service) FindByIpAddressWithContext(ctx context.Context, ipAddress string) (datatypes.SoftLayer_Hardware, error) {
 
 	ipAddressParameters := datatypes.SoftLayer_Hardware_String_Parameters{
 		Parameters: []string{ipAddress},
@@ -115,14 +134,13 @@ func (slhs *softLayer_Hardware_Service) FindByIpAddress(ipAddress string) (datat
 		"datacenter.id",
 	}
 
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/findByIpAddress.json", slhs.GetName()), objectMask, "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/findByIpAddress.json", slhs.GetName()), objectMask, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Hardware{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#findByIpAddress, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Hardware{}, common.NewSoftLayerError("SoftLayer_Hardware", "findByIpAddress", errorCode, response)
 	}
 
 	hardware := datatypes.SoftLayer_Hardware{}
@@ -135,6 +153,10 @@ func (slhs *softLayer_Hardware_Service) FindByIpAddress(ipAddress string) (datat
 }
 
 func (slhs *softLayer_Hardware_Service) GetObject(id int) (datatypes.SoftLayer_Hardware, error) {
+	return slhs.GetObjectWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Hardware, error) {
 
 	objectMask := []string{
 		"bareMetalInstanceFlag",
@@ -153,19 +175,18 @@ func (slhs *softLayer_Hardware_Service) GetObject(id int) (datatypes.SoftLayer_H
 		"datacenter.id",
 	}
 
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slhs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", slhs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Hardware{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Hardware{}, common.NewSoftLayerError("SoftLayer_Hardware", "getObject", errorCode, response)
 	}
 
 	err = slhs.client.GetHttpClient().CheckForHttpResponseErrors(response)
 	if err != nil {
-		return datatypes.SoftLayer_Hardware{}, err
+		return datatypes.SoftLayer_Hardware{}, common.WithService(err, "SoftLayer_Hardware", "getObject")
 	}
 
 	hardware := datatypes.SoftLayer_Hardware{}
@@ -178,6 +199,10 @@ func (slhs *softLayer_Hardware_Service) GetObject(id int) (datatypes.SoftLayer_H
 }
 
 func (slhs *softLayer_Hardware_Service) GetAttachedNetworkStorages(id int, nasType string) ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slhs.GetAttachedNetworkStoragesWithContext(context.Background(), id, nasType)
+}
+
+func (slhs *softLayer_Hardware_Service) GetAttachedNetworkStoragesWithContext(ctx context.Context, id int, nasType string) ([]datatypes.SoftLayer_Network_Storage, error) {
 
 	nasTypeParameters := datatypes.SoftLayer_Hardware_String_Parameters{
 		Parameters: []string{nasType},
@@ -209,19 +234,18 @@ func (slhs *softLayer_Hardware_Service) GetAttachedNetworkStorages(id int, nasTy
 		"serviceResourceBackendIpAddress",
 	}
 
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getAttachedNetworkStorages.json", slhs.GetName(), id), objectMask, "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getAttachedNetworkStorages.json", slhs.GetName(), id), objectMask, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return []datatypes.SoftLayer_Network_Storage{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getAttachedNetworkStorages, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Storage{}, common.NewSoftLayerError("SoftLayer_Hardware", "getAttachedNetworkStorages", errorCode, response)
 	}
 
 	err = slhs.client.GetHttpClient().CheckForHttpResponseErrors(response)
 	if err != nil {
-		return []datatypes.SoftLayer_Network_Storage{}, err
+		return []datatypes.SoftLayer_Network_Storage{}, common.WithService(err, "SoftLayer_Hardware", "getAttachedNetworkStorages")
 	}
 
 	storageList := []datatypes.SoftLayer_Network_Storage{}
@@ -233,15 +257,41 @@ func (slhs *softLayer_Hardware_Service) GetAttachedNetworkStorages(id int, nasTy
 	return storageList, nil
 }
 
+func (slhs *softLayer_Hardware_Service) GetActiveTransactions(id int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slhs.GetActiveTransactionsWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetActiveTransactionsWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getActiveTransactions.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Hardware", "getActiveTransactions", errorCode, response)
+	}
+
+	activeTransactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	err = json.Unmarshal(response, &activeTransactions)
+	if err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	return activeTransactions, nil
+}
+
 func (slhs *softLayer_Hardware_Service) GetAllowedHost(id int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getAllowedHost.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	return slhs.GetAllowedHostWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetAllowedHostWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getAllowedHost.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage_Allowed_Host{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getAllowedHost, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Network_Storage_Allowed_Host{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Network_Storage_Allowed_Host{}, common.NewSoftLayerError("SoftLayer_Hardware", "getAllowedHost", errorCode, response)
 	}
 
 	allowedHost := datatypes.SoftLayer_Network_Storage_Allowed_Host{}
@@ -254,14 +304,17 @@ func (slhs *softLayer_Hardware_Service) GetAllowedHost(id int) (datatypes.SoftLa
 }
 
 func (slhs *softLayer_Hardware_Service) GetDatacenter(id int) (datatypes.SoftLayer_Location, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getDatacenter.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	return slhs.GetDatacenterWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetDatacenterWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Location, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getDatacenter.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Location{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getDatacenter, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Location{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Location{}, common.NewSoftLayerError("SoftLayer_Hardware", "getDatacenter", errorCode, response)
 	}
 
 	datacenter := datatypes.SoftLayer_Location{}
@@ -273,43 +326,75 @@ func (slhs *softLayer_Hardware_Service) GetDatacenter(id int) (datatypes.SoftLay
 	return datacenter, nil
 }
 
+func (slhs *softLayer_Hardware_Service) GetServerPowerState(id int) (string, error) {
+	return slhs.GetServerPowerStateWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetServerPowerStateWithContext(ctx context.Context, id int) (string, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getServerPowerState.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return "", err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		return "", common.NewSoftLayerError("SoftLayer_Hardware", "getServerPowerState", errorCode, response)
+	}
+
+	powerState := ""
+	err = json.Unmarshal(response, &powerState)
+	if err != nil {
+		return "", err
+	}
+
+	return powerState, nil
+}
+
 func (slhs *softLayer_Hardware_Service) GetPrimaryIpAddress(id int) (string, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	return slhs.GetPrimaryIpAddressWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetPrimaryIpAddressWithContext(ctx context.Context, id int) (string, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return "", err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getPrimaryIpAddress, HTTP error code: '%d'", errorCode)
-		return "", errors.New(errorMessage)
+		return "", common.NewSoftLayerError("SoftLayer_Hardware", "getPrimaryIpAddress", errorCode, response)
 	}
 
 	return string(response[:]), nil
 }
 
 func (slhs *softLayer_Hardware_Service) GetPrimaryBackendIpAddress(id int) (string, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryBackendIpAddress.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	return slhs.GetPrimaryBackendIpAddressWithContext(context.Background(), id)
+}
+
+func (slhs *softLayer_Hardware_Service) GetPrimaryBackendIpAddressWithContext(ctx context.Context, id int) (string, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPrimaryBackendIpAddress.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
 	if err != nil {
 		return "", err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getPrimaryBackendIpAddress, HTTP error code: '%d'", errorCode)
-		return "", errors.New(errorMessage)
+		return "", common.NewSoftLayerError("SoftLayer_Hardware", "getPrimaryBackendIpAddress", errorCode, response)
 	}
 
 	return string(response[:]), nil
 }
 
 func (slhs *softLayer_Hardware_Service) PowerOff(instanceId int) (bool, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOff.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slhs.PowerOffWithContext(context.Background(), instanceId)
+}
+
+func (slhs *softLayer_Hardware_Service) PowerOffWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerOff.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#powerOff, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "powerOff", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -320,96 +405,115 @@ func (slhs *softLayer_Hardware_Service) PowerOff(instanceId int) (bool, error) {
 }
 
 func (slhs *softLayer_Hardware_Service) PowerOffSoft(instanceId int) (bool, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOffSoft.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slhs.PowerOffSoftWithContext(context.Background(), instanceId)
+}
+
+func (slhs *softLayer_Hardware_Service) PowerOffSoftWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerOffSoft.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power off soft hardware with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "powerOffSoft", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#powerOffSoft, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to power off soft hardware with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, nil
 }
 
 func (slhs *softLayer_Hardware_Service) PowerOn(instanceId int) (bool, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOn.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slhs.PowerOnWithContext(context.Background(), instanceId)
+}
+
+func (slhs *softLayer_Hardware_Service) PowerOnWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerOn.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power on hardware with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "powerOn", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#powerOn, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to power on hardware with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, nil
 }
 
 func (slhs *softLayer_Hardware_Service) RebootDefault(instanceId int) (bool, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootDefault.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slhs.RebootDefaultWithContext(context.Background(), instanceId)
+}
+
+func (slhs *softLayer_Hardware_Service) RebootDefaultWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/rebootDefault.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to default reboot hardware with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "rebootDefault", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#rebootDefault, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to default reboot hardware with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, nil
 }
 
 func (slhs *softLayer_Hardware_Service) RebootSoft(instanceId int) (bool, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootSoft.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slhs.RebootSoftWithContext(context.Background(), instanceId)
+}
+
+func (slhs *softLayer_Hardware_Service) RebootSoftWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/rebootSoft.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to soft reboot hardware with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "rebootSoft", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#rebootSoft, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to soft reboot hardware with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, nil
 }
 
 func (slhs *softLayer_Hardware_Service) RebootHard(instanceId int) (bool, error) {
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootHard.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slhs.RebootHardWithContext(context.Background(), instanceId)
+}
+
+func (slhs *softLayer_Hardware_Service) RebootHardWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/rebootHard.json", slhs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to hard reboot hardware with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "rebootHard", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#rebootHard, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to hard reboot hardware with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, nil
 }
 
 func (slhs *softLayer_Hardware_Service) SetTags(instanceId int, tags []string) (bool, error) {
+	return slhs.SetTagsWithContext(context.Background(), instanceId, tags)
+}
+
+func (slhs *softLayer_Hardware_Service) SetTagsWithContext(ctx context.Context, instanceId int, tags []string) (bool, error) {
 	var tagStringBuffer bytes.Buffer
 	for i, tag := range tags {
 		tagStringBuffer.WriteString(tag)
@@ -427,14 +531,13 @@ func (slhs *softLayer_Hardware_Service) SetTags(instanceId int, tags []string) (
 		return false, err
 	}
 
-	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/setTags.json", slhs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/setTags.json", slhs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#setTags, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Hardware", "setTags", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -276,6 +276,43 @@ var _ = Describe("SoftLayer_Hardware", func() {
 		})
 	})
 
+	Context("#GetActiveTransactions", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getActiveTransactions.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("sucessfully retrieves the active transactions", func() {
+			activeTransactions, err := hardwareService.GetActiveTransactions(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(activeTransactions).To(HaveLen(2))
+			Expect(activeTransactions[0].TransactionGroup.Name).To(Equal("Reload Operating System"))
+			Expect(activeTransactions[0].TransactionStatus.Name).To(Equal("RELOAD_OPERATING_SYSTEM"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetActiveTransactions(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetActiveTransactions(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
 	Context("#GetDatacenter", func() {
 		BeforeEach(func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getDatacenter.json")
@@ -310,6 +347,40 @@ var _ = Describe("SoftLayer_Hardware", func() {
 		})
 	})
 
+	Context("#GetServerPowerState", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"on"`)
+		})
+
+		It("sucessfully retrieves the server power state", func() {
+			powerState, err := hardwareService.GetServerPowerState(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState).To(Equal("on"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetServerPowerState(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetServerPowerState(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
 	Context("#GetPrimaryIpAddress", func() {
 		BeforeEach(func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("159.99.99.99")
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,14 +11,15 @@ import (
 
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	filter "github.com/maximilien/softlayer-go/filter"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
 const (
 	NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID = 222
 	BLOCK_ITEM_PRICE_ID                    = 40678 // file or block item price id
-	CREATE_ISCSI_VOLUME_MAX_RETRY_TIME     = 60
-	CREATE_ISCSI_VOLUME_CHECK_INTERVAL     = 5 // seconds
+	CREATE_ISCSI_VOLUME_TIMEOUT            = 5 * time.Minute
+	CREATE_ISCSI_VOLUME_CHECK_INTERVAL     = 5 * time.Second
 )
 
 type softLayer_Network_Storage_Service struct {
@@ -34,12 +36,24 @@ func (slns *softLayer_Network_Storage_Service) GetName() string {
 	return "SoftLayer_Network_Storage"
 }
 
+func (slns *softLayer_Network_Storage_Service) WithMask(mask ...string) softlayer.SoftLayer_Network_Storage_Service {
+	if len(mask) == 0 {
+		return slns
+	}
+
+	return NewSoftLayer_Network_Storage_Service(newObjectMaskClient(slns.client, mask, datatypes.SoftLayer_Network_Storage{}))
+}
+
 func (slns *softLayer_Network_Storage_Service) CreateIscsiVolume(size int, location string) (datatypes.SoftLayer_Network_Storage, error) {
+	return slns.CreateIscsiVolumeWithContext(context.Background(), size, location)
+}
+
+func (slns *softLayer_Network_Storage_Service) CreateIscsiVolumeWithContext(ctx context.Context, size int, location string) (datatypes.SoftLayer_Network_Storage, error) {
 	if size < 0 {
 		return datatypes.SoftLayer_Network_Storage{}, errors.New("Cannot create negative sized volumes")
 	}
 
-	sizeItemPriceId, err := slns.getIscsiVolumeItemIdBasedOnSize(size)
+	sizeItemPriceId, err := slns.getIscsiVolumeItemIdBasedOnSize(ctx, size)
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
@@ -73,49 +87,46 @@ func (slns *softLayer_Network_Storage_Service) CreateIscsiVolume(size int, locat
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
 
-	receipt, err := productOrderService.PlaceContainerOrderNetworkPerformanceStorageIscsi(order)
+	receipt, err := productOrderService.PlaceContainerOrderNetworkPerformanceStorageIscsiWithContext(ctx, order)
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
 
-	var iscsiStorage datatypes.SoftLayer_Network_Storage
-
-	for i := 0; i < CREATE_ISCSI_VOLUME_MAX_RETRY_TIME; i++ {
-		iscsiStorage, err = slns.findIscsiVolumeId(receipt.OrderId)
-		if err == nil {
-			break
-		} else if i == CREATE_ISCSI_VOLUME_MAX_RETRY_TIME-1 {
-			return datatypes.SoftLayer_Network_Storage{}, err
-		}
-
-		time.Sleep(CREATE_ISCSI_VOLUME_CHECK_INTERVAL * time.Second)
-	}
-
-	return iscsiStorage, nil
+	return NewOrderTracker(slns.client).WaitForNetworkStorage(ctx, receipt, OrderTrackOptions{
+		PollingInterval: CREATE_ISCSI_VOLUME_CHECK_INTERVAL,
+		Timeout:         CREATE_ISCSI_VOLUME_TIMEOUT,
+	})
 }
 
 func (slvgs *softLayer_Network_Storage_Service) DeleteObject(volumeId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slvgs.GetName(), volumeId), "DELETE", new(bytes.Buffer))
+	return slvgs.DeleteObjectWithContext(context.Background(), volumeId)
+}
+
+func (slvgs *softLayer_Network_Storage_Service) DeleteObjectWithContext(ctx context.Context, volumeId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d.json", slvgs.GetName(), volumeId), "DELETE", new(bytes.Buffer))
 
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to delete volume with id '%d', got '%s' as response from the API.", volumeId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Network_Storage", "deleteObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#deleteObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete volume with id '%d', got '%s' as response from the API.", volumeId, res))
 	}
 
 	return true, err
 }
 
 func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolume(volumeId int, immediateCancellationFlag bool) error {
+	return slns.DeleteIscsiVolumeWithContext(context.Background(), volumeId, immediateCancellationFlag)
+}
 
-	billingItem, err := slns.GetBillingItem(volumeId)
+func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolumeWithContext(ctx context.Context, volumeId int, immediateCancellationFlag bool) error {
+
+	billingItem, err := slns.GetBillingItemWithContext(ctx, volumeId)
 	if err != nil {
 		return err
 	}
@@ -126,7 +137,7 @@ func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolume(volumeId int, i
 			return err
 		}
 
-		deleted, err := billingItemService.CancelService(billingItem.Id)
+		deleted, err := billingItemService.CancelServiceWithContext(ctx, billingItem.Id)
 		if err != nil {
 			return err
 		}
@@ -142,6 +153,10 @@ func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolume(volumeId int, i
 }
 
 func (slns *softLayer_Network_Storage_Service) GetIscsiVolume(volumeId int) (datatypes.SoftLayer_Network_Storage, error) {
+	return slns.GetIscsiVolumeWithContext(context.Background(), volumeId)
+}
+
+func (slns *softLayer_Network_Storage_Service) GetIscsiVolumeWithContext(ctx context.Context, volumeId int) (datatypes.SoftLayer_Network_Storage, error) {
 	objectMask := []string{
 		"accountId",
 		"capacityGb",
@@ -162,15 +177,14 @@ func (slns *softLayer_Network_Storage_Service) GetIscsiVolume(volumeId int) (dat
 		"serviceResourceBackendIpAddress",
 	}
 
-	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
 
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getAccountStatus, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Network_Storage{}, common.NewSoftLayerError("SoftLayer_Network_Storage", "getObject", errorCode, response)
 	}
 
 	volume := datatypes.SoftLayer_Network_Storage{}
@@ -183,15 +197,18 @@ func (slns *softLayer_Network_Storage_Service) GetIscsiVolume(volumeId int) (dat
 }
 
 func (slns *softLayer_Network_Storage_Service) GetBillingItem(volumeId int) (datatypes.SoftLayer_Billing_Item, error) {
+	return slns.GetBillingItemWithContext(context.Background(), volumeId)
+}
+
+func (slns *softLayer_Network_Storage_Service) GetBillingItemWithContext(ctx context.Context, volumeId int) (datatypes.SoftLayer_Billing_Item, error) {
 
-	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getBillingItem.json", slns.GetName(), volumeId), "GET", new(bytes.Buffer))
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getBillingItem.json", slns.GetName(), volumeId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Billing_Item{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_NetWork_Storage#getBillingItem, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Billing_Item{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Billing_Item{}, common.NewSoftLayerError("SoftLayer_Network_Storage", "getBillingItem", errorCode, response)
 	}
 
 	billingItem := datatypes.SoftLayer_Billing_Item{}
@@ -204,16 +221,19 @@ func (slns *softLayer_Network_Storage_Service) GetBillingItem(volumeId int) (dat
 }
 
 func (slns *softLayer_Network_Storage_Service) HasAllowedVirtualGuest(volumeId int, vmId int) (bool, error) {
-	filter := string(`{"allowedVirtualGuests":{"id":{"operation":"` + strconv.Itoa(vmId) + `"}}}`)
-	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(fmt.Sprintf("%s/%d/getAllowedVirtualGuests.json", slns.GetName(), volumeId), []string{"id"}, fmt.Sprintf(string(filter)), "GET", new(bytes.Buffer))
+	return slns.HasAllowedVirtualGuestWithContext(context.Background(), volumeId, vmId)
+}
+
+func (slns *softLayer_Network_Storage_Service) HasAllowedVirtualGuestWithContext(ctx context.Context, volumeId int, vmId int) (bool, error) {
+	objectFilter := filter.Path("allowedVirtualGuests.id").Eq(vmId).Build()
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getAllowedVirtualGuests.json", slns.GetName(), volumeId), []string{"id"}, objectFilter, "GET", new(bytes.Buffer))
 
 	if err != nil {
 		return false, errors.New(fmt.Sprintf("Cannot check authentication for volume %d in vm %d", volumeId, vmId))
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#hasAllowedVirtualGuest, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Network_Storage", "hasAllowedVirtualGuest", errorCode, response)
 	}
 
 	virtualGuest := []datatypes.SoftLayer_Virtual_Guest{}
@@ -230,6 +250,10 @@ func (slns *softLayer_Network_Storage_Service) HasAllowedVirtualGuest(volumeId i
 }
 
 func (slns *softLayer_Network_Storage_Service) AttachIscsiVolume(virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) (bool, error) {
+	return slns.AttachIscsiVolumeWithContext(context.Background(), virtualGuest, volumeId)
+}
+
+func (slns *softLayer_Network_Storage_Service) AttachIscsiVolumeWithContext(ctx context.Context, virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Parameters{
 		Parameters: []datatypes.SoftLayer_Virtual_Guest{
 			virtualGuest,
@@ -240,15 +264,14 @@ func (slns *softLayer_Network_Storage_Service) AttachIscsiVolume(virtualGuest da
 		return false, err
 	}
 
-	resp, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/allowAccessFromVirtualGuest.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
+	resp, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/allowAccessFromVirtualGuest.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
 
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#attachIscsiVolume, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Network_Storage", "allowAccessFromVirtualGuest", errorCode, resp)
 	}
 
 	allowable, err := strconv.ParseBool(string(resp[:]))
@@ -260,6 +283,10 @@ func (slns *softLayer_Network_Storage_Service) AttachIscsiVolume(virtualGuest da
 }
 
 func (slns *softLayer_Network_Storage_Service) DetachIscsiVolume(virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) error {
+	return slns.DetachIscsiVolumeWithContext(context.Background(), virtualGuest, volumeId)
+}
+
+func (slns *softLayer_Network_Storage_Service) DetachIscsiVolumeWithContext(ctx context.Context, virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) error {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Parameters{
 		Parameters: []datatypes.SoftLayer_Virtual_Guest{
 			virtualGuest,
@@ -270,14 +297,13 @@ func (slns *softLayer_Network_Storage_Service) DetachIscsiVolume(virtualGuest da
 		return err
 	}
 
-	_, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/removeAccessFromVirtualGuest.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/removeAccessFromVirtualGuest.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getAccountStatus, HTTP error code: '%d'", errorCode)
-		return errors.New(errorMessage)
+		return common.NewSoftLayerError("SoftLayer_Network_Storage", "removeAccessFromVirtualGuest", errorCode, response)
 	}
 
 	return nil
@@ -285,33 +311,13 @@ func (slns *softLayer_Network_Storage_Service) DetachIscsiVolume(virtualGuest da
 
 // Private methods
 
-func (slns *softLayer_Network_Storage_Service) findIscsiVolumeId(orderId int) (datatypes.SoftLayer_Network_Storage, error) {
-	ObjectFilter := string(`{"iscsiNetworkStorage":{"billingItem":{"orderItem":{"order":{"id":{"operation":` + strconv.Itoa(orderId) + `}}}}}}`)
-
-	accountService, err := slns.client.GetSoftLayer_Account_Service()
-	if err != nil {
-		return datatypes.SoftLayer_Network_Storage{}, err
-	}
-
-	iscsiStorages, err := accountService.GetIscsiNetworkStorageWithFilter(ObjectFilter)
-	if err != nil {
-		return datatypes.SoftLayer_Network_Storage{}, err
-	}
-
-	if len(iscsiStorages) == 1 {
-		return iscsiStorages[0], nil
-	}
-
-	return datatypes.SoftLayer_Network_Storage{}, errors.New(fmt.Sprintf("Cannot find an performance storage (iSCSI volume) with order id %d", orderId))
-}
-
-func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(size int) (int, error) {
+func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(ctx context.Context, size int) (int, error) {
 	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
 	if err != nil {
 		return 0, err
 	}
 
-	itemPrices, err := productPackageService.GetItemPricesBySize(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, size)
+	itemPrices, err := productPackageService.GetItemPricesBySizeWithContext(ctx, NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, size)
 	if err != nil {
 		return 0, err
 	}
@@ -2,8 +2,8 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	common "github.com/maximilien/softlayer-go/common"
@@ -25,15 +25,26 @@ func (slns *softLayer_Network_Storage_Allowed_Host_Service) GetName() string {
 	return "SoftLayer_Network_Storage_Allowed_Host"
 }
 
+func (slns *softLayer_Network_Storage_Allowed_Host_Service) WithMask(mask ...string) softlayer.SoftLayer_Network_Storage_Allowed_Host_Service {
+	if len(mask) == 0 {
+		return slns
+	}
+
+	return NewSoftLayer_Network_Storage_Allowed_Host_Service(newObjectMaskClient(slns.client, mask, datatypes.SoftLayer_Network_Storage_Allowed_Host{}))
+}
+
 func (slns *softLayer_Network_Storage_Allowed_Host_Service) GetCredential(allowedHostId int) (datatypes.SoftLayer_Network_Storage_Credential, error) {
-	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getCredential.json", slns.GetName(), allowedHostId), "GET", new(bytes.Buffer))
+	return slns.GetCredentialWithContext(context.Background(), allowedHostId)
+}
+
+func (slns *softLayer_Network_Storage_Allowed_Host_Service) GetCredentialWithContext(ctx context.Context, allowedHostId int) (datatypes.SoftLayer_Network_Storage_Credential, error) {
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getCredential.json", slns.GetName(), allowedHostId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage_Credential{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage_Allowed_Host#getCredential, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Network_Storage_Credential{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Network_Storage_Credential{}, common.NewSoftLayerError("SoftLayer_Network_Storage_Allowed_Host", "getCredential", errorCode, response)
 	}
 
 	credential := datatypes.SoftLayer_Network_Storage_Credential{}
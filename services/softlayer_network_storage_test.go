@@ -54,6 +54,24 @@ var _ = Describe("SoftLayer_Network_Storage", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("orders the volume and finds it with the billing items of its order", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("GET", `SoftLayer_Product_Package/222/getItemPrices\.json`).RespondWithFixture("SoftLayer_Product_Package_getItemPrices.json")
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/placeOrder\.json`).RespondWithFixture("SoftLayer_Product_Order_PlaceContainerOrderNetworkPerformanceStorageIscsi.json")
+			fakeHttpClient.Route("GET", `SoftLayer_Billing_Order/123/getObject\.json`).RespondWithFixture("SoftLayer_Billing_Order_Service_getObject_provisioned.json")
+			fakeHttpClient.Route("GET", `SoftLayer_Network_Storage/1/getObject\.json`).RespondWithFixture("SoftLayer_Network_Storage_Service_getIscsiVolume.json")
+
+			volume, err = networkStorageService.CreateIscsiVolume(20, "fake-location")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volume.Id).To(Equal(1))
+			Expect(volume.Username).To(Equal("test_username"))
+
+			Expect(fakeHttpClient.Calls).To(HaveLen(4))
+			Expect(fakeHttpClient.CallsTo("GET", `SoftLayer_Product_Package/\d+/getItemPrices\.json`)[0].Filters).To(ContainSubstring("20_GB_PERFORMANCE_STORAGE_SPACE"))
+			Expect(fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeOrder\.json`)[0].Body).To(ContainSubstring(`"id":123`))
+			Expect(fakeHttpClient.CallsTo("GET", `SoftLayer_Billing_Order/123/getObject\.json`)[0].Masks).To(ContainElement("orderTopLevelItems.billingItem.resourceTableId"))
+		})
+
 		It("fails with error if the volume size is negative", func() {
 			volume, err = networkStorageService.CreateIscsiVolume(-1, "fake-location")
 			Expect(err).To(HaveOccurred())
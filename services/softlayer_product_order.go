@@ -2,9 +2,12 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
@@ -25,7 +28,19 @@ func (slpo *softLayer_Product_Order_Service) GetName() string {
 	return "SoftLayer_Product_Order"
 }
 
+func (slpo *softLayer_Product_Order_Service) WithMask(mask ...string) softlayer.SoftLayer_Product_Order_Service {
+	if len(mask) == 0 {
+		return slpo
+	}
+
+	return NewSoftLayer_Product_Order_Service(newObjectMaskClient(slpo.client, mask, nil))
+}
+
 func (slpo *softLayer_Product_Order_Service) PlaceOrder(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	return slpo.PlaceOrderWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceOrderWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
 	parameters := datatypes.SoftLayer_Container_Product_Order_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Product_Order{
 			order,
@@ -37,14 +52,13 @@ func (slpo *softLayer_Product_Order_Service) PlaceOrder(order datatypes.SoftLaye
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getAccountStatus, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, common.NewSoftLayerError("SoftLayer_Product_Order", "placeOrder", errorCode, responseBytes)
 	}
 
 	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
@@ -57,6 +71,10 @@ func (slpo *softLayer_Product_Order_Service) PlaceOrder(order datatypes.SoftLaye
 }
 
 func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	return slpo.PlaceContainerOrderNetworkPerformanceStorageIscsiWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkPerformanceStorageIscsiWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
 	parameters := datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{
 			order,
@@ -68,14 +86,13 @@ func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkPerforman
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeContainerOrderNetworkPerformanceStorageIscsi, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, common.NewSoftLayerError("SoftLayer_Product_Order", "placeOrder", errorCode, responseBytes)
 	}
 
 	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
@@ -88,6 +105,10 @@ func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkPerforman
 }
 
 func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	return slpo.PlaceContainerOrderVirtualGuestUpgradeWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderVirtualGuestUpgradeWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
 	parameters := datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
 			order,
@@ -99,14 +120,13 @@ func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderVirtualGuestUpgr
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeOrder, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, common.NewSoftLayerError("SoftLayer_Product_Order", "placeOrder", errorCode, responseBytes)
 	}
 
 	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
@@ -117,3 +137,201 @@ func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderVirtualGuestUpgr
 
 	return receipt, nil
 }
+
+// VerifyOrder checks the order with SoftLayer without placing it, returning what it would cost
+func (slpo *softLayer_Product_Order_Service) VerifyOrder(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	return slpo.VerifyOrderWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) VerifyOrderWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	return slpo.verifyOrder(ctx, order)
+}
+
+func (slpo *softLayer_Product_Order_Service) VerifyContainerOrderNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	return slpo.VerifyContainerOrderNetworkPerformanceStorageIscsiWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) VerifyContainerOrderNetworkPerformanceStorageIscsiWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	return slpo.verifyOrder(ctx, order)
+}
+
+func (slpo *softLayer_Product_Order_Service) VerifyContainerOrderVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	return slpo.VerifyContainerOrderVirtualGuestUpgradeWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) VerifyContainerOrderVirtualGuestUpgradeWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	return slpo.verifyOrder(ctx, order)
+}
+
+// PlaceQuote saves the order as a quote of the account instead of placing it, returning the quote and what the order would cost
+func (slpo *softLayer_Product_Order_Service) PlaceQuote(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	return slpo.PlaceQuoteWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceQuoteWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	return slpo.placeQuote(ctx, order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerQuoteNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	return slpo.PlaceContainerQuoteNetworkPerformanceStorageIscsiWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerQuoteNetworkPerformanceStorageIscsiWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	return slpo.placeQuote(ctx, order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerQuoteVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	return slpo.PlaceContainerQuoteVirtualGuestUpgradeWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerQuoteVirtualGuestUpgradeWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	return slpo.placeQuote(ctx, order)
+}
+
+//Private methods
+
+func (slpo *softLayer_Product_Order_Service) verifyOrder(ctx context.Context, order interface{}) (datatypes.SoftLayer_Product_Order_Price_Summary, error) {
+	verifiedOrder := pricedOrder{}
+	err := slpo.postOrder(ctx, "verifyOrder", order, &verifiedOrder)
+	if err != nil {
+		return datatypes.SoftLayer_Product_Order_Price_Summary{}, err
+	}
+
+	return verifiedOrder.summary(), nil
+}
+
+func (slpo *softLayer_Product_Order_Service) placeQuote(ctx context.Context, order interface{}) (datatypes.SoftLayer_Product_Order_Quote, error) {
+	receipt := quoteReceipt{}
+	err := slpo.postOrder(ctx, "placeQuote", order, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Product_Order_Quote{}, err
+	}
+
+	return datatypes.SoftLayer_Product_Order_Quote{
+		QuoteId: receipt.Quote.Id,
+		Name:    receipt.Quote.Name,
+		Summary: receipt.OrderDetails.summary(),
+	}, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) postOrder(ctx context.Context, method string, order interface{}, result interface{}) error {
+	requestBody, err := json.Marshal(map[string]interface{}{
+		"parameters": []interface{}{order},
+	})
+	if err != nil {
+		return err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%s.json", slpo.GetName(), method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		return common.NewSoftLayerError("SoftLayer_Product_Order", method, errorCode, responseBytes)
+	}
+
+	return json.Unmarshal(responseBytes, result)
+}
+
+//Private types
+
+// pricedOrder holds the pricing properties of the SoftLayer_Container_Product_Order returned by verifyOrder and placeQuote
+type pricedOrder struct {
+	Prices []struct {
+		Id         int `json:"id"`
+		Categories []struct {
+			CategoryCode string `json:"categoryCode"`
+		} `json:"categories"`
+		Item struct {
+			Id          int    `json:"id"`
+			Description string `json:"description"`
+		} `json:"item"`
+
+		HourlyRecurringFee decimal `json:"hourlyRecurringFee"`
+		RecurringFee       decimal `json:"recurringFee"`
+		SetupFee           decimal `json:"setupFee"`
+		OneTimeFee         decimal `json:"oneTimeFee"`
+		LaborFee           decimal `json:"laborFee"`
+	} `json:"prices"`
+
+	UseHourlyPricing bool `json:"useHourlyPricing"`
+
+	PreTaxRecurring        decimal `json:"preTaxRecurring"`
+	PreTaxRecurringHourly  decimal `json:"preTaxRecurringHourly"`
+	PreTaxRecurringMonthly decimal `json:"preTaxRecurringMonthly"`
+	PreTaxSetup            decimal `json:"preTaxSetup"`
+	TotalRecurringTax      decimal `json:"totalRecurringTax"`
+	TotalSetupTax          decimal `json:"totalSetupTax"`
+	PostTaxRecurring       decimal `json:"postTaxRecurring"`
+	PostTaxSetup           decimal `json:"postTaxSetup"`
+}
+
+func (po pricedOrder) summary() datatypes.SoftLayer_Product_Order_Price_Summary {
+	summary := datatypes.SoftLayer_Product_Order_Price_Summary{
+		Items: []datatypes.SoftLayer_Product_Order_Item_Price{},
+
+		HourlyPricing: po.UseHourlyPricing,
+
+		RecurringFee:        float64(po.PreTaxRecurring),
+		HourlyRecurringFee:  float64(po.PreTaxRecurringHourly),
+		MonthlyRecurringFee: float64(po.PreTaxRecurringMonthly),
+		SetupFee:            float64(po.PreTaxSetup),
+
+		RecurringTax: float64(po.TotalRecurringTax),
+		SetupTax:     float64(po.TotalSetupTax),
+
+		TotalRecurringFee: float64(po.PostTaxRecurring),
+		TotalSetupFee:     float64(po.PostTaxSetup),
+	}
+
+	for _, price := range po.Prices {
+		itemPrice := datatypes.SoftLayer_Product_Order_Item_Price{
+			PriceId:     price.Id,
+			ItemId:      price.Item.Id,
+			Description: price.Item.Description,
+
+			HourlyRecurringFee: float64(price.HourlyRecurringFee),
+			RecurringFee:       float64(price.RecurringFee),
+			SetupFee:           float64(price.SetupFee),
+			OneTimeFee:         float64(price.OneTimeFee),
+			LaborFee:           float64(price.LaborFee),
+		}
+
+		if len(price.Categories) > 0 {
+			itemPrice.CategoryCode = price.Categories[0].CategoryCode
+		}
+
+		summary.Items = append(summary.Items, itemPrice)
+	}
+
+	return summary
+}
+
+type quoteReceipt struct {
+	Quote struct {
+		Id   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"quote"`
+
+	OrderDetails pricedOrder `json:"orderDetails"`
+}
+
+// decimal is an amount SoftLayer returns either as a string (e.g. ".025") or as a number
+type decimal float64
+
+func (d *decimal) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "" || value == "null" {
+		*d = 0
+		return nil
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return errors.New(fmt.Sprintf("softlayer-go cannot parse the amount %s", string(data)))
+	}
+
+	*d = decimal(amount)
+	return nil
+}
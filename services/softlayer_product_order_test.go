@@ -150,4 +150,284 @@ var _ = Describe("SoftLayer_Product_Order", func() {
 			})
 		})
 	})
+
+	Context("#VerifyOrder", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_verifyOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the price summary of the order", func() {
+			summary, err := productOrderService.VerifyOrder(datatypes.SoftLayer_Container_Product_Order{PackageId: 222})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(summary.HourlyPricing).To(BeFalse())
+			Expect(summary.RecurringFee).To(Equal(12.0))
+			Expect(summary.MonthlyRecurringFee).To(Equal(12.0))
+			Expect(summary.HourlyRecurringFee).To(Equal(0.0))
+			Expect(summary.SetupFee).To(Equal(5.5))
+			Expect(summary.RecurringTax).To(Equal(0.96))
+			Expect(summary.SetupTax).To(Equal(0.44))
+			Expect(summary.TotalRecurringFee).To(Equal(12.96))
+			Expect(summary.TotalSetupFee).To(Equal(5.94))
+
+			Expect(summary.Items).To(HaveLen(3))
+			Expect(summary.Items[0]).To(Equal(datatypes.SoftLayer_Product_Order_Item_Price{
+				PriceId:      40672,
+				ItemId:       5598,
+				Description:  "20 GB Storage Space",
+				CategoryCode: "performance_storage_space",
+				RecurringFee: 2,
+			}))
+			Expect(summary.Items[1].SetupFee).To(Equal(5.5))
+			Expect(summary.Items[2].CategoryCode).To(Equal("performance_storage_iscsi"))
+		})
+
+		It("posts the order to verifyOrder", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/verifyOrder\.json`).RespondWithFixture("SoftLayer_Product_Order_verifyOrder.json")
+
+			_, err := productOrderService.VerifyOrder(datatypes.SoftLayer_Container_Product_Order{ComplexType: "fake-complex-type", PackageId: 222})
+			Expect(err).ToNot(HaveOccurred())
+
+			calls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/verifyOrder\.json`)
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Body).To(MatchJSON(`{"parameters": [{"complexType": "fake-complex-type", "packageId": 222}]}`))
+			Expect(fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeOrder\.json`)).To(BeEmpty())
+		})
+
+		It("fails for amounts that are not numbers", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"postTaxRecurring": "fake-amount"}`)
+
+			_, err := productOrderService.VerifyOrder(datatypes.SoftLayer_Container_Product_Order{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyOrder(datatypes.SoftLayer_Container_Product_Order{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyOrder(datatypes.SoftLayer_Container_Product_Order{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#VerifyContainerOrderNetworkPerformanceStorageIscsi", func() {
+		It("posts the order to verifyOrder and returns its price summary", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/verifyOrder\.json`).RespondWithFixture("SoftLayer_Product_Order_verifyOrder.json")
+
+			summary, err := productOrderService.VerifyContainerOrderNetworkPerformanceStorageIscsi(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{
+				ComplexType: "SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi",
+				PackageId:   222,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary.TotalRecurringFee).To(Equal(12.96))
+
+			calls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/verifyOrder\.json`)
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Body).To(ContainSubstring(`"complexType":"SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi"`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyContainerOrderNetworkPerformanceStorageIscsi(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyContainerOrderNetworkPerformanceStorageIscsi(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#VerifyContainerOrderVirtualGuestUpgrade", func() {
+		It("posts the order to verifyOrder and returns its price summary", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/verifyOrder\.json`).RespondWithFixture("SoftLayer_Product_Order_verifyOrder.json")
+
+			summary, err := productOrderService.VerifyContainerOrderVirtualGuestUpgrade(datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
+				ComplexType:   "SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade",
+				VirtualGuests: []datatypes.VirtualGuest{datatypes.VirtualGuest{Id: 1234567}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary.Items).To(HaveLen(3))
+
+			calls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/verifyOrder\.json`)
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Body).To(ContainSubstring(`"virtualGuests":[{"id":1234567}]`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyContainerOrderVirtualGuestUpgrade(datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyContainerOrderVirtualGuestUpgrade(datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlaceQuote", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeQuote.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the quote and the price summary of the order", func() {
+			quote, err := productOrderService.PlaceQuote(datatypes.SoftLayer_Container_Product_Order{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(quote.QuoteId).To(Equal(1234567))
+			Expect(quote.Name).To(Equal("fake-quote"))
+			Expect(quote.Summary.HourlyPricing).To(BeTrue())
+			Expect(quote.Summary.HourlyRecurringFee).To(Equal(0.04))
+			Expect(quote.Summary.TotalRecurringFee).To(Equal(0.04))
+			Expect(quote.Summary.Items).To(Equal([]datatypes.SoftLayer_Product_Order_Item_Price{
+				datatypes.SoftLayer_Product_Order_Item_Price{
+					PriceId:            1641,
+					ItemId:             859,
+					Description:        "2 x 2.0 GHz Cores",
+					CategoryCode:       "guest_core",
+					HourlyRecurringFee: 0.04,
+				},
+			}))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceQuote(datatypes.SoftLayer_Container_Product_Order{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceQuote(datatypes.SoftLayer_Container_Product_Order{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlaceContainerQuoteNetworkPerformanceStorageIscsi", func() {
+		It("posts the order to placeQuote", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/placeQuote\.json`).RespondWithFixture("SoftLayer_Product_Order_placeQuote.json")
+
+			quote, err := productOrderService.PlaceContainerQuoteNetworkPerformanceStorageIscsi(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{PackageId: 222})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quote.QuoteId).To(Equal(1234567))
+
+			calls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeQuote\.json`)
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Body).To(ContainSubstring(`"packageId":222`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerQuoteNetworkPerformanceStorageIscsi(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerQuoteNetworkPerformanceStorageIscsi(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlaceContainerQuoteVirtualGuestUpgrade", func() {
+		It("posts the order to placeQuote", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/placeQuote\.json`).RespondWithFixture("SoftLayer_Product_Order_placeQuote.json")
+
+			quote, err := productOrderService.PlaceContainerQuoteVirtualGuestUpgrade(datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
+				VirtualGuests: []datatypes.VirtualGuest{datatypes.VirtualGuest{Id: 1234567}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quote.Summary.Items).To(HaveLen(1))
+
+			calls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeQuote\.json`)
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Body).To(ContainSubstring(`"virtualGuests":[{"id":1234567}]`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerQuoteVirtualGuestUpgrade(datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerQuoteVirtualGuestUpgrade(datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
 })
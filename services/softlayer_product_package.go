@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	filter "github.com/maximilien/softlayer-go/filter"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
@@ -31,15 +33,26 @@ func (slpp *softLayer_Product_Package_Service) GetName() string {
 	return "SoftLayer_Product_Package"
 }
 
+func (slpp *softLayer_Product_Package_Service) WithMask(mask ...string) softlayer.SoftLayer_Product_Package_Service {
+	if len(mask) == 0 {
+		return slpp
+	}
+
+	return NewSoftLayer_Product_Package_Service(newObjectMaskClient(slpp.client, mask, nil))
+}
+
 func (slpp *softLayer_Product_Package_Service) GetItemPrices(packageId int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
-	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getItemPrices.json", slpp.GetName(), packageId), []string{"id", "item.id", "item.description", "item.capacity"}, "GET", new(bytes.Buffer))
+	return slpp.GetItemPricesWithContext(context.Background(), packageId)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetItemPricesWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getItemPrices.json", slpp.GetName(), packageId), []string{"id", "item.id", "item.description", "item.capacity"}, "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item_Price{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Package#getItemPrices, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Product_Item_Price{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Product_Item_Price{}, common.NewSoftLayerError("SoftLayer_Product_Package", "getItemPrices", errorCode, response)
 	}
 
 	itemPrices := []datatypes.SoftLayer_Product_Item_Price{}
@@ -52,17 +65,20 @@ func (slpp *softLayer_Product_Package_Service) GetItemPrices(packageId int) ([]d
 }
 
 func (slpp *softLayer_Product_Package_Service) GetItemPricesBySize(packageId int, size int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	return slpp.GetItemPricesBySizeWithContext(context.Background(), packageId, size)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetItemPricesBySizeWithContext(ctx context.Context, packageId int, size int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
 	keyName := strconv.Itoa(size) + "_GB_PERFORMANCE_STORAGE_SPACE"
-	filter := string(`{"itemPrices":{"item":{"keyName":{"operation":"` + keyName + `"}}}}`)
+	objectFilter := filter.Path("itemPrices.item.keyName").Eq(keyName).Build()
 
-	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(fmt.Sprintf("%s/%d/getItemPrices.json", slpp.GetName(), packageId), []string{"id", "locationGroupId", "item.id", "item.keyName", "item.units", "item.description", "item.capacity"}, fmt.Sprintf(string(filter)), "GET", new(bytes.Buffer))
+	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getItemPrices.json", slpp.GetName(), packageId), []string{"id", "locationGroupId", "item.id", "item.keyName", "item.units", "item.description", "item.capacity"}, objectFilter, "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item_Price{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Package#getItemsPricesBySize, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Product_Item_Price{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Product_Item_Price{}, common.NewSoftLayerError("SoftLayer_Product_Package", "getItemPrices", errorCode, response)
 	}
 
 	itemPrices := []datatypes.SoftLayer_Product_Item_Price{}
@@ -75,15 +91,23 @@ func (slpp *softLayer_Product_Package_Service) GetItemPricesBySize(packageId int
 }
 
 func (slpp *softLayer_Product_Package_Service) GetItemsByType(packageType string) ([]datatypes.SoftLayer_Product_Item, error) {
-	productPackage, err := slpp.GetOnePackageByType(packageType)
+	return slpp.GetItemsByTypeWithContext(context.Background(), packageType)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetItemsByTypeWithContext(ctx context.Context, packageType string) ([]datatypes.SoftLayer_Product_Item, error) {
+	productPackage, err := slpp.GetOnePackageByTypeWithContext(ctx, packageType)
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item{}, err
 	}
 
-	return slpp.GetItems(productPackage.Id)
+	return slpp.GetItemsWithContext(ctx, productPackage.Id)
 }
 
 func (slpp *softLayer_Product_Package_Service) GetItems(packageId int) ([]datatypes.SoftLayer_Product_Item, error) {
+	return slpp.GetItemsWithContext(context.Background(), packageId)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetItemsWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Product_Item, error) {
 	objectMasks := []string{
 		"id",
 		"capacity",
@@ -93,14 +117,13 @@ func (slpp *softLayer_Product_Package_Service) GetItems(packageId int) ([]dataty
 		"prices.categories.name",
 	}
 
-	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getItems.json", slpp.GetName(), packageId), objectMasks, "GET", new(bytes.Buffer))
+	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getItems.json", slpp.GetName(), packageId), objectMasks, "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Package#getItems, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Product_Item{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Product_Item{}, common.NewSoftLayerError("SoftLayer_Product_Package", "getItems", errorCode, response)
 	}
 
 	productItems := []datatypes.SoftLayer_Product_Item{}
@@ -113,7 +136,11 @@ func (slpp *softLayer_Product_Package_Service) GetItems(packageId int) ([]dataty
 }
 
 func (slpp *softLayer_Product_Package_Service) GetOnePackageByType(packageType string) (datatypes.Softlayer_Product_Package, error) {
-	productPackages, err := slpp.GetPackagesByType(packageType)
+	return slpp.GetOnePackageByTypeWithContext(context.Background(), packageType)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetOnePackageByTypeWithContext(ctx context.Context, packageType string) (datatypes.Softlayer_Product_Package, error) {
+	productPackages, err := slpp.GetPackagesByTypeWithContext(ctx, packageType)
 	if err != nil {
 		return datatypes.Softlayer_Product_Package{}, err
 	}
@@ -126,6 +153,10 @@ func (slpp *softLayer_Product_Package_Service) GetOnePackageByType(packageType s
 }
 
 func (slpp *softLayer_Product_Package_Service) GetPackagesByType(packageType string) ([]datatypes.Softlayer_Product_Package, error) {
+	return slpp.GetPackagesByTypeWithContext(context.Background(), packageType)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetPackagesByTypeWithContext(ctx context.Context, packageType string) ([]datatypes.Softlayer_Product_Package, error) {
 	objectMasks := []string{
 		"id",
 		"name",
@@ -134,16 +165,15 @@ func (slpp *softLayer_Product_Package_Service) GetPackagesByType(packageType str
 		"type.keyName",
 	}
 
-	filterObject := string(`{"type":{"keyName":{"operation":"` + packageType + `"}}}`)
+	filterObject := filter.Path("type.keyName").Eq(packageType).Build()
 
-	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(fmt.Sprintf("%s/getAllObjects.json", slpp.GetName()), objectMasks, filterObject, "GET", new(bytes.Buffer))
+	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx, fmt.Sprintf("%s/getAllObjects.json", slpp.GetName()), objectMasks, filterObject, "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.Softlayer_Product_Package{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Package#getPackagesByType, HTTP error code: '%d'", errorCode)
-		return []datatypes.Softlayer_Product_Package{}, errors.New(errorMessage)
+		return []datatypes.Softlayer_Product_Package{}, common.NewSoftLayerError("SoftLayer_Product_Package", "getAllObjects", errorCode, response)
 	}
 
 	productPackages := []*datatypes.Softlayer_Product_Package{}
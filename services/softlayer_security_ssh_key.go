@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,7 +26,19 @@ func (slssks *softLayer_Security_Ssh_Key_Service) GetName() string {
 	return "SoftLayer_Security_Ssh_Key"
 }
 
+func (slssks *softLayer_Security_Ssh_Key_Service) WithMask(mask ...string) softlayer.SoftLayer_Security_Ssh_Key_Service {
+	if len(mask) == 0 {
+		return slssks
+	}
+
+	return NewSoftLayer_Security_Ssh_Key_Service(newObjectMaskClient(slssks.client, mask, datatypes.SoftLayer_Security_Ssh_Key{}))
+}
+
 func (slssks *softLayer_Security_Ssh_Key_Service) CreateObject(template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error) {
+	return slssks.CreateObjectWithContext(context.Background(), template)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error) {
 	parameters := datatypes.SoftLayer_Shh_Key_Parameters{
 		Parameters: []datatypes.SoftLayer_Security_Ssh_Key{
 			template,
@@ -37,19 +50,18 @@ func (slssks *softLayer_Security_Ssh_Key_Service) CreateObject(template datatype
 		return datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
-	data, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/createObject", slssks.GetName()), "POST", bytes.NewBuffer(requestBody))
+	data, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/createObject", slssks.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Security_Ssh_Key#createObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Security_Ssh_Key{}, common.NewSoftLayerError("SoftLayer_Security_Ssh_Key", "createObject", errorCode, data)
 	}
 
 	err = slssks.client.GetHttpClient().CheckForHttpResponseErrors(data)
 	if err != nil {
-		return datatypes.SoftLayer_Security_Ssh_Key{}, err
+		return datatypes.SoftLayer_Security_Ssh_Key{}, common.WithService(err, "SoftLayer_Security_Ssh_Key", "createObject")
 	}
 
 	softLayer_Ssh_Key := datatypes.SoftLayer_Security_Ssh_Key{}
@@ -62,6 +74,10 @@ func (slssks *softLayer_Security_Ssh_Key_Service) CreateObject(template datatype
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) GetObject(sshKeyId int) (datatypes.SoftLayer_Security_Ssh_Key, error) {
+	return slssks.GetObjectWithContext(context.Background(), sshKeyId)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) GetObjectWithContext(ctx context.Context, sshKeyId int) (datatypes.SoftLayer_Security_Ssh_Key, error) {
 	objectMask := []string{
 		"createDate",
 		"fingerprint",
@@ -72,14 +88,13 @@ func (slssks *softLayer_Security_Ssh_Key_Service) GetObject(sshKeyId int) (datat
 		"notes",
 	}
 
-	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slssks.GetName(), sshKeyId), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", slssks.GetName(), sshKeyId), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Security_Ssh_Key#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Security_Ssh_Key{}, common.NewSoftLayerError("SoftLayer_Security_Ssh_Key", "getObject", errorCode, response)
 	}
 
 	sshKey := datatypes.SoftLayer_Security_Ssh_Key{}
@@ -92,6 +107,10 @@ func (slssks *softLayer_Security_Ssh_Key_Service) GetObject(sshKeyId int) (datat
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) EditObject(sshKeyId int, template datatypes.SoftLayer_Security_Ssh_Key) (bool, error) {
+	return slssks.EditObjectWithContext(context.Background(), sshKeyId, template)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) EditObjectWithContext(ctx context.Context, sshKeyId int, template datatypes.SoftLayer_Security_Ssh_Key) (bool, error) {
 	parameters := datatypes.SoftLayer_Shh_Key_Parameters{
 		Parameters: []datatypes.SoftLayer_Security_Ssh_Key{
 			template,
@@ -103,50 +122,55 @@ func (slssks *softLayer_Security_Ssh_Key_Service) EditObject(sshKeyId int, templ
 		return false, err
 	}
 
-	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slssks.GetName(), sshKeyId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/editObject.json", slssks.GetName(), sshKeyId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to edit SSH key with id: %d, got '%s' as response from the API.", sshKeyId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Security_Ssh_Key", "editObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Security_Ssh_Key#editObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit SSH key with id: %d, got '%s' as response from the API.", sshKeyId, res))
 	}
 
 	return true, err
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) DeleteObject(sshKeyId int) (bool, error) {
-	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slssks.GetName(), sshKeyId), "DELETE", new(bytes.Buffer))
+	return slssks.DeleteObjectWithContext(context.Background(), sshKeyId)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) DeleteObjectWithContext(ctx context.Context, sshKeyId int) (bool, error) {
+	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d.json", slssks.GetName(), sshKeyId), "DELETE", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to destroy ssh key with id '%d', got '%s' as response from the API.", sshKeyId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Security_Ssh_Key", "deleteObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Security_Ssh_Key#deleteObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to destroy ssh key with id '%d', got '%s' as response from the API.", sshKeyId, res))
 	}
 
 	return true, err
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) GetSoftwarePasswords(sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error) {
-	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getSoftwarePasswords.json", slssks.GetName(), sshKeyId), "GET", new(bytes.Buffer))
+	return slssks.GetSoftwarePasswordsWithContext(context.Background(), sshKeyId)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) GetSoftwarePasswordsWithContext(ctx context.Context, sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error) {
+	response, errorCode, err := slssks.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getSoftwarePasswords.json", slssks.GetName(), sshKeyId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Software_Component_Password{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Security_Ssh_Key#getSoftwarePasswords, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Software_Component_Password{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Software_Component_Password{}, common.NewSoftLayerError("SoftLayer_Security_Ssh_Key", "getSoftwarePasswords", errorCode, response)
 	}
 
 	passwords := []datatypes.SoftLayer_Software_Component_Password{}
@@ -2,8 +2,8 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	common "github.com/maximilien/softlayer-go/common"
@@ -25,15 +25,26 @@ func (slvdi *softLayer_Virtual_Disk_Image_Service) GetName() string {
 	return "SoftLayer_Virtual_Disk_Image"
 }
 
+func (slvdi *softLayer_Virtual_Disk_Image_Service) WithMask(mask ...string) softlayer.SoftLayer_Virtual_Disk_Image_Service {
+	if len(mask) == 0 {
+		return slvdi
+	}
+
+	return NewSoftLayer_Virtual_Disk_Image_Service(newObjectMaskClient(slvdi.client, mask, datatypes.SoftLayer_Virtual_Disk_Image{}))
+}
+
 func (slvdi *softLayer_Virtual_Disk_Image_Service) GetObject(vdImageId int) (datatypes.SoftLayer_Virtual_Disk_Image, error) {
-	response, errorCode, err := slvdi.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slvdi.GetName(), vdImageId), "GET", new(bytes.Buffer))
+	return slvdi.GetObjectWithContext(context.Background(), vdImageId)
+}
+
+func (slvdi *softLayer_Virtual_Disk_Image_Service) GetObjectWithContext(ctx context.Context, vdImageId int) (datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	response, errorCode, err := slvdi.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", slvdi.GetName(), vdImageId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Disk_Image{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Disk_Image#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Disk_Image{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Disk_Image{}, common.NewSoftLayerError("SoftLayer_Virtual_Disk_Image", "getObject", errorCode, response)
 	}
 
 	vdImage := datatypes.SoftLayer_Virtual_Disk_Image{}
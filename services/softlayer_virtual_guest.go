@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -13,6 +14,7 @@ import (
 
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	filter "github.com/maximilien/softlayer-go/filter"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
@@ -39,7 +41,19 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetName() string {
 	return "SoftLayer_Virtual_Guest"
 }
 
+func (slvgs *softLayer_Virtual_Guest_Service) WithMask(mask ...string) softlayer.SoftLayer_Virtual_Guest_Service {
+	if len(mask) == 0 {
+		return slvgs
+	}
+
+	return NewSoftLayer_Virtual_Guest_Service(newObjectMaskClient(slvgs.client, mask, datatypes.SoftLayer_Virtual_Guest{}))
+}
+
 func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.CreateObjectWithContext(context.Background(), template)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error) {
 	err := slvgs.checkCreateObjectRequiredValues(template)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
@@ -56,19 +70,18 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.So
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s.json", slvgs.GetName()), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s.json", slvgs.GetName()), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#createObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "createObject", errorCode, response)
 	}
 
 	err = slvgs.client.GetHttpClient().CheckForHttpResponseErrors(response)
 	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest{}, err
+		return datatypes.SoftLayer_Virtual_Guest{}, common.WithService(err, "SoftLayer_Virtual_Guest", "createObject")
 	}
 
 	softLayer_Virtual_Guest := datatypes.SoftLayer_Virtual_Guest{}
@@ -81,6 +94,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.So
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystem(instanceId int, template datatypes.Image_Template_Config) error {
+	return slvgs.ReloadOperatingSystemWithContext(context.Background(), instanceId, template)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystemWithContext(ctx context.Context, instanceId int, template datatypes.Image_Template_Config) error {
 	parameter := [2]interface{}{"FORCE", template}
 	parameters := map[string]interface{}{
 		"parameters": parameter,
@@ -91,14 +108,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystem(instanceId i
 		return err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/reloadOperatingSystem.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/reloadOperatingSystem.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#reloadOperatingSystem, HTTP error code: '%d'", errorCode)
-		return errors.New(errorMessage)
+		return common.NewSoftLayerError("SoftLayer_Virtual_Guest", "reloadOperatingSystem", errorCode, response)
 	}
 
 	if res := string(response[:]); res != `"1"` {
@@ -109,6 +125,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystem(instanceId i
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.GetObjectWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetObjectWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest, error) {
 
 	objectMask := []string{
 		"accountId",
@@ -155,14 +175,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatyp
 		"primaryBackendNetworkComponent.networkVlan.id",
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getObject.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getObject, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getObject", errorCode, response)
 	}
 
 	virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
@@ -175,15 +194,19 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatyp
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetObjectByPrimaryIpAddress(ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.GetObjectByPrimaryIpAddressWithContext(context.Background(), ipAddress)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetObjectByPrimaryIpAddressWithContext(ctx context.Context, ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error) {
 
-	ObjectFilter := string(`{"virtualGuests":{"primaryIpAddress":{"operation":"` + ipAddress + `"}}}`)
+	ObjectFilter := filter.Path("virtualGuests.primaryIpAddress").Eq(ipAddress).Build()
 
 	accountService, err := slvgs.client.GetSoftLayer_Account_Service()
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
-	virtualGuests, err := accountService.GetVirtualGuestsByFilter(ObjectFilter)
+	virtualGuests, err := accountService.GetVirtualGuestsByFilterWithContext(ctx, ObjectFilter)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
@@ -196,15 +219,19 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetObjectByPrimaryIpAddress(ipAddr
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetObjectByPrimaryBackendIpAddress(ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.GetObjectByPrimaryBackendIpAddressWithContext(context.Background(), ipAddress)
+}
 
-	ObjectFilter := string(`{"virtualGuests":{"primaryBackendIpAddress":{"operation":` + ipAddress + `}}}`)
+func (slvgs *softLayer_Virtual_Guest_Service) GetObjectByPrimaryBackendIpAddressWithContext(ctx context.Context, ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error) {
+
+	ObjectFilter := filter.Path("virtualGuests.primaryBackendIpAddress").Eq(ipAddress).Build()
 
 	accountService, err := slvgs.client.GetSoftLayer_Account_Service()
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
-	virtualGuests, err := accountService.GetVirtualGuestsByFilter(ObjectFilter)
+	virtualGuests, err := accountService.GetVirtualGuestsByFilterWithContext(ctx, ObjectFilter)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
@@ -217,6 +244,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetObjectByPrimaryBackendIpAddress
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) EditObject(instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error) {
+	return slvgs.EditObjectWithContext(context.Background(), instanceId, template)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) EditObjectWithContext(ctx context.Context, instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Parameters{
 		Parameters: []datatypes.SoftLayer_Virtual_Guest{template},
 	}
@@ -226,50 +257,55 @@ func (slvgs *softLayer_Virtual_Guest_Service) EditObject(instanceId int, templat
 		return false, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/editObject.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to edit virtual guest with id: %d, got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "editObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#editObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit virtual guest with id: %d, got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) DeleteObject(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slvgs.GetName(), instanceId), "DELETE", new(bytes.Buffer))
+	return slvgs.DeleteObjectWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) DeleteObjectWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d.json", slvgs.GetName(), instanceId), "DELETE", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to delete instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "deleteObject", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#deleteObject, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPowerState(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPowerState.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetPowerStateWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPowerStateWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPowerState.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Power_State{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPowerState, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest_Power_State{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest_Power_State{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getPowerState", errorCode, response)
 	}
 
 	vgPowerState := datatypes.SoftLayer_Virtual_Guest_Power_State{}
@@ -282,14 +318,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPowerState(instanceId int) (dat
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryIpAddress(instanceId int) (string, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetPrimaryIpAddressWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryIpAddressWithContext(ctx context.Context, instanceId int) (string, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return "", err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPrimaryIpAddress, HTTP error code: '%d'", errorCode)
-		return "", errors.New(errorMessage)
+		return "", common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getPrimaryIpAddress", errorCode, response)
 	}
 
 	vgPrimaryIpAddress := strings.TrimSpace(string(response))
@@ -301,14 +340,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryIpAddress(instanceId int
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendIpAddress(instanceId int) (string, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryBackendIpAddress.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetPrimaryBackendIpAddressWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendIpAddressWithContext(ctx context.Context, instanceId int) (string, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPrimaryBackendIpAddress.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return "", err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPrimaryBackendIpAddress, HTTP error code: '%d'", errorCode)
-		return "", errors.New(errorMessage)
+		return "", common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getPrimaryBackendIpAddress", errorCode, response)
 	}
 
 	vgPrimaryBackendIpAddress := strings.TrimSpace(string(response))
@@ -320,14 +362,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendIpAddress(instanc
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getActiveTransaction.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetActiveTransactionWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactionWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getActiveTransaction.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getActiveTransaction, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getActiveTransaction", errorCode, response)
 	}
 
 	activeTransaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -340,18 +385,21 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransaction(instanceId in
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetLastTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slvgs.GetLastTransactionWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetLastTransactionWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	objectMask := []string{
 		"transactionGroup",
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getLastTransaction.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMaskWithContext(ctx, fmt.Sprintf("%s/%d/getLastTransaction.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getLastTransaction, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getLastTransaction", errorCode, response)
 	}
 
 	lastTransaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -364,14 +412,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetLastTransaction(instanceId int)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getActiveTransactions.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetActiveTransactionsWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactionsWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getActiveTransactions.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getActiveTransactions, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getActiveTransactions", errorCode, response)
 	}
 
 	activeTransactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -384,14 +435,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactions(instanceId i
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetSshKeys(instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getSshKeys.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetSshKeysWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetSshKeysWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getSshKeys.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getSshKeys, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Security_Ssh_Key{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getSshKeys", errorCode, response)
 	}
 
 	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
@@ -404,132 +458,157 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetSshKeys(instanceId int) ([]data
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerCycle(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerCycle.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerCycleWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerCycleWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerCycle.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power cycle instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "powerCycle", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#powerCycle, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to power cycle instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerOff(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOff.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerOffWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerOffWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerOff.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power off instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "powerOff", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#powerOff, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to power off instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerOffSoft(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOffSoft.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerOffSoftWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerOffSoftWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerOffSoft.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power off soft instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "powerOffSoft", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#powerOffSoft, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to power off soft instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerOn(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOn.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerOnWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerOnWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/powerOn.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power on instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "powerOn", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#powerOn, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to power on instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) RebootDefault(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootDefault.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.RebootDefaultWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) RebootDefaultWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/rebootDefault.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to default reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "rebootDefault", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#rebootDefault, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to default reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) RebootSoft(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootSoft.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.RebootSoftWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) RebootSoftWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/rebootSoft.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to soft reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "rebootSoft", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#rebootSoft, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to soft reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) RebootHard(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootHard.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.RebootHardWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) RebootHardWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/rebootHard.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to hard reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "rebootHard", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#rebootHard, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to hard reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) SetMetadata(instanceId int, metadata string) (bool, error) {
+	return slvgs.SetMetadataWithContext(context.Background(), instanceId, metadata)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) SetMetadataWithContext(ctx context.Context, instanceId int, metadata string) (bool, error) {
 	dataBytes := []byte(metadata)
 	base64EncodedMetadata := base64.StdEncoding.EncodeToString(dataBytes)
 
@@ -544,32 +623,34 @@ func (slvgs *softLayer_Virtual_Guest_Service) SetMetadata(instanceId int, metada
 		return false, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/setUserMetadata.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/setUserMetadata.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
-	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to setUserMetadata for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	if common.IsHttpErrorCode(errorCode) {
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "setUserMetadata", errorCode, response)
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#setUserMetadata, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to setUserMetadata for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ConfigureMetadataDisk(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/configureMetadataDisk.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ConfigureMetadataDiskWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ConfigureMetadataDiskWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/configureMetadataDisk.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#setUserMetadata, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "configureMetadataDisk", errorCode, response)
 	}
 
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -582,14 +663,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) ConfigureMetadataDisk(instanceId i
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetUserData(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getUserData.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetUserDataWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetUserDataWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getUserData.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Virtual_Guest_Attribute{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getUserData, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Guest_Attribute{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Virtual_Guest_Attribute{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getUserData", errorCode, response)
 	}
 
 	attributes := []datatypes.SoftLayer_Virtual_Guest_Attribute{}
@@ -602,14 +686,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetUserData(instanceId int) ([]dat
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) IsPingable(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/isPingable.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.IsPingableWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) IsPingableWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/isPingable.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#isPingable, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "isPingable", errorCode, response)
 	}
 
 	res := string(response)
@@ -626,14 +713,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) IsPingable(instanceId int) (bool,
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) IsBackendPingable(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/isBackendPingable.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.IsBackendPingableWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) IsBackendPingableWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/isBackendPingable.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#isBackendPingable, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "isBackendPingable", errorCode, response)
 	}
 
 	res := string(response)
@@ -650,7 +740,11 @@ func (slvgs *softLayer_Virtual_Guest_Service) IsBackendPingable(instanceId int)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDisk(instanceId int, diskSize int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
-	diskItemPrice, err := slvgs.findUpgradeItemPriceForEphemeralDisk(instanceId, diskSize)
+	return slvgs.AttachEphemeralDiskWithContext(context.Background(), instanceId, diskSize)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDiskWithContext(ctx context.Context, instanceId int, diskSize int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	diskItemPrice, err := slvgs.findUpgradeItemPriceForEphemeralDisk(ctx, instanceId, diskSize)
 	if err != nil {
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
@@ -689,7 +783,7 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDisk(instanceId int
 		},
 	}
 
-	receipt, err := orderService.PlaceContainerOrderVirtualGuestUpgrade(order)
+	receipt, err := orderService.PlaceContainerOrderVirtualGuestUpgradeWithContext(ctx, order)
 	if err != nil {
 		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
@@ -697,19 +791,37 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDisk(instanceId int
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) UpgradeObject(instanceId int, options *softlayer.UpgradeOptions) (bool, error) {
-	prices, err := slvgs.GetAvailableUpgradeItemPrices(options)
+	return slvgs.UpgradeObjectWithContext(context.Background(), instanceId, options)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) UpgradeObjectWithContext(ctx context.Context, instanceId int, options *softlayer.UpgradeOptions) (bool, error) {
+	receipt, err := slvgs.PlaceUpgradeOrderWithContext(ctx, instanceId, options)
 	if err != nil {
 		return false, err
 	}
 
+	return receipt.OrderId != 0, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PlaceUpgradeOrder(instanceId int, options *softlayer.UpgradeOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	return slvgs.PlaceUpgradeOrderWithContext(context.Background(), instanceId, options)
+}
+
+// PlaceUpgradeOrderWithContext is UpgradeObjectWithContext returning the receipt of the upgrade order, an empty receipt when there is nothing to upgrade
+func (slvgs *softLayer_Virtual_Guest_Service) PlaceUpgradeOrderWithContext(ctx context.Context, instanceId int, options *softlayer.UpgradeOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	prices, err := slvgs.GetAvailableUpgradeItemPricesWithContext(ctx, options)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
 	if len(prices) == 0 {
 		// Nothing to order, as all the values are up to date
-		return false, nil
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, nil
 	}
 
 	orderService, err := slvgs.client.GetSoftLayer_Product_Order_Service()
 	if err != nil {
-		return false, err
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
 	order := datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
@@ -728,15 +840,14 @@ func (slvgs *softLayer_Virtual_Guest_Service) UpgradeObject(instanceId int, opti
 		},
 	}
 
-	_, err = orderService.PlaceContainerOrderVirtualGuestUpgrade(order)
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return orderService.PlaceContainerOrderVirtualGuestUpgradeWithContext(ctx, order)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetAvailableUpgradeItemPrices(upgradeOptions *softlayer.UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	return slvgs.GetAvailableUpgradeItemPricesWithContext(context.Background(), upgradeOptions)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetAvailableUpgradeItemPricesWithContext(ctx context.Context, upgradeOptions *softlayer.UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error) {
 	itemsCapacity := make(map[string]int)
 	if upgradeOptions.Cpus > 0 {
 		itemsCapacity["cpus"] = upgradeOptions.Cpus
@@ -748,7 +859,7 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetAvailableUpgradeItemPrices(upgr
 		itemsCapacity["nic_speed"] = upgradeOptions.NicSpeed
 	}
 
-	virtualServerPackageItems, err := slvgs.getVirtualServerItems()
+	virtualServerPackageItems, err := slvgs.getVirtualServerItems(ctx)
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item_Price{}, err
 	}
@@ -768,14 +879,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetAvailableUpgradeItemPrices(upgr
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPrices(instanceId int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetUpgradeItemPricesWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPricesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item_Price{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getUpgradeItemPrices, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Product_Item_Price{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Product_Item_Price{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getUpgradeItemPrices", errorCode, response)
 	}
 
 	itemPrices := []datatypes.SoftLayer_Product_Item_Price{}
@@ -788,6 +902,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPrices(instanceId in
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []string) (bool, error) {
+	return slvgs.SetTagsWithContext(context.Background(), instanceId, tags)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) SetTagsWithContext(ctx context.Context, instanceId int, tags []string) (bool, error) {
 	var tagStringBuffer bytes.Buffer
 	for i, tag := range tags {
 		tagStringBuffer.WriteString(tag)
@@ -805,14 +923,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []str
 		return false, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/setTags.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/setTags.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#setTags, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "setTags", errorCode, response)
 	}
 
 	if res := string(response[:]); res != "true" {
@@ -823,14 +940,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []str
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getTagReferences.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetTagReferencesWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferencesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getTagReferences.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Tag_Reference{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getTagReferences, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Tag_Reference{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Tag_Reference{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getTagReferences", errorCode, response)
 	}
 
 	tagReferences := []datatypes.SoftLayer_Tag_Reference{}
@@ -843,6 +963,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferences(instanceId int) (
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slvgs.AttachDiskImageWithContext(context.Background(), instanceId, imageId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	parameters := datatypes.SoftLayer_Virtual_GuestInit_ImageId_Parameters{
 		Parameters: datatypes.ImageId_Parameter{
 			ImageId: imageId,
@@ -854,14 +978,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImage(instanceId int, im
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/attachDiskImage.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/attachDiskImage.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#attachDiskImage, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "attachDiskImage", errorCode, response)
 	}
 
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -874,6 +997,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImage(instanceId int, im
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slvgs.DetachDiskImageWithContext(context.Background(), instanceId, imageId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	parameters := datatypes.SoftLayer_Virtual_GuestInit_ImageId_Parameters{
 		Parameters: datatypes.ImageId_Parameter{
 			ImageId: imageId,
@@ -885,14 +1012,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImage(instanceId int, im
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/detachDiskImage.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/detachDiskImage.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#detachDiskImage, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "detachDiskImage", errorCode, response)
 	}
 
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -905,14 +1031,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImage(instanceId int, im
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ActivatePrivatePort(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/activatePrivatePort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ActivatePrivatePortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ActivatePrivatePortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/activatePrivatePort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#activatePrivatePort, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "activatePrivatePort", errorCode, response)
 	}
 
 	res := string(response)
@@ -929,14 +1058,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) ActivatePrivatePort(instanceId int
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ActivatePublicPort(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/activatePublicPort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ActivatePublicPortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ActivatePublicPortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/activatePublicPort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#activatePublicPort, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "activatePublicPort", errorCode, response)
 	}
 
 	res := string(response)
@@ -953,14 +1085,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) ActivatePublicPort(instanceId int)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPrivatePort(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/shutdownPrivatePort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ShutdownPrivatePortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPrivatePortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/shutdownPrivatePort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#shutdownPrivatePort, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "shutdownPrivatePort", errorCode, response)
 	}
 
 	res := string(response)
@@ -977,14 +1112,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPrivatePort(instanceId int
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPublicPort(instanceId int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/shutdownPublicPort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ShutdownPublicPortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPublicPortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/shutdownPublicPort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#shutdownPublicPort, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "shutdownPublicPort", errorCode, response)
 	}
 
 	res := string(response)
@@ -1001,14 +1139,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPublicPort(instanceId int)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetAllowedHost(instanceId int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getAllowedHost.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetAllowedHostWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetAllowedHostWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getAllowedHost.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage_Allowed_Host{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getAllowedHost, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Network_Storage_Allowed_Host{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Network_Storage_Allowed_Host{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getAllowedHost", errorCode, response)
 	}
 
 	allowedHost := datatypes.SoftLayer_Network_Storage_Allowed_Host{}
@@ -1021,14 +1162,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetAllowedHost(instanceId int) (da
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkVlans(instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getNetworkVlans.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetNetworkVlansWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkVlansWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getNetworkVlans.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Network_Vlan{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getNetworkVlans, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Vlan{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getNetworkVlans", errorCode, response)
 	}
 
 	networkVlans := []datatypes.SoftLayer_Network_Vlan{}
@@ -1041,14 +1185,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkVlans(instanceId int) ([
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkComponents(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Network_Component, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getNetworkComponents.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetNetworkComponentsWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkComponentsWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Network_Component, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getNetworkComponents.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return []datatypes.SoftLayer_Virtual_Guest_Network_Component{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getNetworkComponents failed, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Virtual_Guest_Network_Component{}, errors.New(errorMessage)
+		return []datatypes.SoftLayer_Virtual_Guest_Network_Component{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getNetworkComponents", errorCode, response)
 	}
 
 	networkComponents := []datatypes.SoftLayer_Virtual_Guest_Network_Component{}
@@ -1061,14 +1208,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkComponents(instanceId in
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendNetworkComponent(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryBackendNetworkComponent.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetPrimaryBackendNetworkComponentWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendNetworkComponentWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPrimaryBackendNetworkComponent.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Network_Component{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getPrimaryBackendNetworkComponent failed, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest_Network_Component{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest_Network_Component{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getPrimaryBackendNetworkComponent", errorCode, response)
 	}
 
 	networkComponent := datatypes.SoftLayer_Virtual_Guest_Network_Component{}
@@ -1081,14 +1231,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendNetworkComponent(
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryNetworkComponent(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryNetworkComponent.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetPrimaryNetworkComponentWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryNetworkComponentWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/getPrimaryNetworkComponent.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Network_Component{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getPrimaryNetworkComponent failed, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Virtual_Guest_Network_Component{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Virtual_Guest_Network_Component{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "getPrimaryNetworkComponent", errorCode, response)
 	}
 
 	networkComponent := datatypes.SoftLayer_Virtual_Guest_Network_Component{}
@@ -1101,14 +1254,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryNetworkComponent(instanc
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) CheckHostDiskAvailability(instanceId int, diskCapacity int) (bool, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/checkHostDiskAvailability/%d", slvgs.GetName(), instanceId, diskCapacity), "GET", new(bytes.Buffer))
+	return slvgs.CheckHostDiskAvailabilityWithContext(context.Background(), instanceId, diskCapacity)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CheckHostDiskAvailabilityWithContext(ctx context.Context, instanceId int, diskCapacity int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/checkHostDiskAvailability/%d", slvgs.GetName(), instanceId, diskCapacity), "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#checkHostDiskAvailability, HTTP error code: '%d'", errorCode)
-		return false, errors.New(errorMessage)
+		return false, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "checkHostDiskAvailability", errorCode, response)
 	}
 
 	res := string(response)
@@ -1125,14 +1281,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) CheckHostDiskAvailability(instance
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) CaptureImage(instanceId int) (datatypes.SoftLayer_Container_Disk_Image_Capture_Template, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/captureImage.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.CaptureImageWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CaptureImageWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Container_Disk_Image_Capture_Template, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/captureImage.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#captureImage, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "captureImage", errorCode, response)
 	}
 
 	diskImageTemplate := datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}
@@ -1145,6 +1304,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) CaptureImage(instanceId int) (data
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) CreateArchiveTransaction(instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slvgs.CreateArchiveTransactionWithContext(context.Background(), instanceId, groupName, blockDevices, note)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CreateArchiveTransactionWithContext(ctx context.Context, instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	groupName = url.QueryEscape(groupName)
 	note = url.QueryEscape(note)
 
@@ -1157,14 +1320,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateArchiveTransaction(instanceI
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/createArchiveTransaction.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithContext(ctx, fmt.Sprintf("%s/%d/createArchiveTransaction.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
 	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#createArchiveTransaction, HTTP error code: '%d'", errorCode)
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, common.NewSoftLayerError("SoftLayer_Virtual_Guest", "createArchiveTransaction", errorCode, response)
 	}
 
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
@@ -1178,13 +1340,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateArchiveTransaction(instanceI
 
 //Private methods
 
-func (slvgs *softLayer_Virtual_Guest_Service) getVirtualServerItems() ([]datatypes.SoftLayer_Product_Item, error) {
+func (slvgs *softLayer_Virtual_Guest_Service) getVirtualServerItems(ctx context.Context) ([]datatypes.SoftLayer_Product_Item, error) {
 	service, err := slvgs.client.GetSoftLayer_Product_Package_Service()
 	if err != nil {
 		return []datatypes.SoftLayer_Product_Item{}, err
 	}
 
-	return service.GetItemsByType(VIRTUAL_SERVER_PACKAGE_TYPE)
+	return service.GetItemsByTypeWithContext(ctx, VIRTUAL_SERVER_PACKAGE_TYPE)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) filterProductItemPrice(packageItems []datatypes.SoftLayer_Product_Item, option string, amount int) (datatypes.SoftLayer_Product_Item_Price, error) {
@@ -1268,12 +1430,12 @@ func (slvgs *softLayer_Virtual_Guest_Service) checkCreateObjectRequiredValues(te
 	return err
 }
 
-func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPriceForEphemeralDisk(instanceId int, ephemeralDiskSize int) (datatypes.SoftLayer_Product_Item_Price, error) {
+func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPriceForEphemeralDisk(ctx context.Context, instanceId int, ephemeralDiskSize int) (datatypes.SoftLayer_Product_Item_Price, error) {
 	if ephemeralDiskSize <= 0 {
 		return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Ephemeral disk size can not be negative: %d", ephemeralDiskSize))
 	}
 
-	itemPrices, err := slvgs.GetUpgradeItemPrices(instanceId)
+	itemPrices, err := slvgs.GetUpgradeItemPricesWithContext(ctx, instanceId)
 	if err != nil {
 		return datatypes.SoftLayer_Product_Item_Price{}, nil
 	}
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -9,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
@@ -83,6 +85,16 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			}
 		})
 
+		It("returns a SoftLayerError of createObject for an error in a successful response", func() {
+			fakeClient.FakeHttpClient.CheckForHttpResponseErrorsError = &common.SoftLayerError{StatusCode: 500, Message: "fake-error"}
+
+			_, err = virtualGuestService.CreateObject(virtualGuestTemplate)
+			slError, ok := common.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slError.Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(slError.Method).To(Equal("createObject"))
+		})
+
 		It("creates a new SoftLayer_Virtual_Guest instance", func() {
 			virtualGuest, err = virtualGuestService.CreateObject(virtualGuestTemplate)
 			Expect(err).ToNot(HaveOccurred())
@@ -169,6 +181,35 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(vg.OperatingSystem.Passwords[0].Username).To(Equal("test_username"))
 		})
 
+		It("uses the default object mask", func() {
+			_, err := virtualGuestService.GetObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(ContainElement("primaryNetworkComponent.networkVlan.id"))
+		})
+
+		It("uses the custom object mask passed with WithMask", func() {
+			_, err := virtualGuestService.WithMask("id", "hostname").GetObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(Equal([]string{"id", "hostname"}))
+		})
+
+		It("passes the context through to the HTTP client", func() {
+			ctx := context.WithValue(context.Background(), "fake-key", "fake-value")
+			vg, err := virtualGuestService.GetObjectWithContext(ctx, virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vg.Id).To(Equal(virtualGuest.Id))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestContext).To(Equal(ctx))
+		})
+
+		It("fails when the context is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := virtualGuestService.GetObjectWithContext(ctx, virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(Equal(context.Canceled))
+		})
+
 		Context("when HTTP client returns error codes 40x or 50x", func() {
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
@@ -187,6 +228,22 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 					Expect(err).To(HaveOccurred())
 				}
 			})
+
+			It("returns a SoftLayerError with the SoftLayer exception details", func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestInt = 404
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Unable to find object with id of '1234567'.","code":"SoftLayer_Exception_ObjectNotFound"}`)
+
+				_, err := virtualGuestService.GetObject(virtualGuest.Id)
+				Expect(err).To(HaveOccurred())
+				Expect(common.IsNotFound(err)).To(BeTrue())
+
+				slError, ok := err.(*common.SoftLayerError)
+				Expect(ok).To(BeTrue())
+				Expect(slError.StatusCode).To(Equal(404))
+				Expect(slError.Code).To(Equal("SoftLayer_Exception_ObjectNotFound"))
+				Expect(slError.Service).To(Equal("SoftLayer_Virtual_Guest"))
+				Expect(slError.Method).To(Equal("getObject"))
+			})
 		})
 	})
 
@@ -202,6 +259,79 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("filters on the quoted primary IP address", func() {
+			_, err := virtualGuestService.GetObjectByPrimaryIpAddress(virtualGuest.PrimaryIpAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(MatchJSON(`{"virtualGuests":{"primaryIpAddress":{"operation":"23.246.234.32"}}}`))
+		})
+	})
+
+	Context("#WithMask", func() {
+		It("keeps the default object mask of getters returning other types", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getLastTransaction.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = virtualGuestService.WithMask("id", "hostname").GetLastTransaction(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getLastTransaction.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(Equal([]string{"transactionGroup"}))
+		})
+
+		It("does not add the object mask to getters returning other types without default mask", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`[]`)
+
+			_, err := virtualGuestService.WithMask("id").GetActiveTransactions(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getActiveTransactions.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(BeNil())
+		})
+
+		It("fails GetObject without sending it when the object mask is not valid for SoftLayer_Virtual_Guest", func() {
+			_, err := virtualGuestService.WithMask("id", "fake-property").GetObject(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("fake-property"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(BeEmpty())
+		})
+
+		It("does not add the object mask to mutating calls", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			edited, err := virtualGuestService.WithMask("id").EditObject(1234567, datatypes.SoftLayer_Virtual_Guest{Hostname: "fake-hostname"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(BeNil())
+
+			deleted, err := virtualGuestService.WithMask("id").DeleteObject(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(BeNil())
+		})
+
+		It("does not change the original service", func() {
+			virtualGuestService.WithMask("id")
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"10.0.0.1"`)
+
+			_, err := virtualGuestService.GetPrimaryIpAddress(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getPrimaryIpAddress.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(BeNil())
+		})
+	})
+
+	Context("#GetObjectByPrimaryBackendIpAddress", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getVirtualGuestsByFilter.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("filters on the quoted primary backend IP address", func() {
+			_, err := virtualGuestService.GetObjectByPrimaryBackendIpAddress("10.106.192.42")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(MatchJSON(`{"virtualGuests":{"primaryBackendIpAddress":{"operation":"10.106.192.42"}}}`))
+		})
+
 		Context("when HTTP client returns error codes 40x or 50x", func() {
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
@@ -340,6 +470,19 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 					Expect(err).To(HaveOccurred())
 				}
 			})
+
+			It("returns a SoftLayerError rather than checking the response body", func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestInt = 404
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Unable to find object with id of '1234567'.","code":"SoftLayer_Exception_ObjectNotFound"}`)
+
+				_, err := virtualGuestService.DeleteObject(virtualGuest.Id)
+				Expect(common.IsNotFound(err)).To(BeTrue())
+
+				slError, ok := common.AsSoftLayerError(err)
+				Expect(ok).To(BeTrue())
+				Expect(slError.StatusCode).To(Equal(404))
+				Expect(slError.Method).To(Equal("deleteObject"))
+			})
 		})
 	})
 
@@ -361,6 +504,21 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(receipt.OrderId).NotTo(Equal(0))
 		})
 
+		It("orders the upgrade price of the local disk", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("GET", `SoftLayer_Virtual_Guest/123/getUpgradeItemPrices\.json`).RespondWithFixture("SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices.json")
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/placeOrder\.json`).RespondWithFixture("SoftLayer_Product_Order_PlaceContainerOrderVirtualGuestUpgrade.json")
+
+			receipt, err := virtualGuestService.AttachEphemeralDisk(123, 25)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(123))
+
+			placeOrderCalls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeOrder\.json`)
+			Expect(placeOrderCalls).To(HaveLen(1))
+			Expect(placeOrderCalls[0].Body).To(ContainSubstring(`"id":12345`))
+			Expect(placeOrderCalls[0].Body).To(ContainSubstring(`"categoryCode":"guest_disk1"`))
+		})
+
 		Context("when HTTP client returns error codes 40x or 50x", func() {
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
@@ -426,6 +584,30 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 		})
 	})
 
+	Context("#PlaceUpgradeOrder", func() {
+		It("returns the receipt of the upgrade order", func() {
+			fakeHttpClient := fakeClient.FakeHttpClient
+			fakeHttpClient.Route("GET", `SoftLayer_Product_Package/getAllObjects\.json`).RespondWithFixture("SoftLayer_Product_Package_getAllObjects_virtual_server.json")
+			fakeHttpClient.Route("GET", `SoftLayer_Product_Package/\d+/getItems\.json`).RespondWithFixture("SoftLayer_Product_Package_getItemsByType_virtual_server.json")
+			fakeHttpClient.Route("POST", `SoftLayer_Product_Order/placeOrder\.json`).RespondWithFixture("SoftLayer_Product_Order_PlaceContainerOrderVirtualGuestUpgrade.json")
+
+			receipt, err := virtualGuestService.PlaceUpgradeOrder(123, &softlayer.UpgradeOptions{Cpus: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(123))
+
+			placeOrderCalls := fakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeOrder\.json`)
+			Expect(placeOrderCalls).To(HaveLen(1))
+			Expect(placeOrderCalls[0].Body).To(ContainSubstring(`"complexType":"SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade"`))
+		})
+
+		It("returns an empty receipt when there is nothing to upgrade", func() {
+			receipt, err := virtualGuestService.PlaceUpgradeOrder(123, &softlayer.UpgradeOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(0))
+			Expect(fakeClient.FakeHttpClient.CallsTo("POST", `SoftLayer_Product_Order/placeOrder\.json`)).To(BeEmpty())
+		})
+	})
+
 	Context("#GetAvailableUpgradeItemPrices", func() {
 		BeforeEach(func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
@@ -484,6 +666,28 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(vgPowerState.KeyName).To(Equal("RUNNING"))
 		})
 
+		It("returns the routed responses in order while polling", func() {
+			fakeClient.FakeHttpClient.Route("GET", `SoftLayer_Virtual_Guest/1234567/getPowerState\.json`).
+				Respond([]byte(`{"keyName":"HALTED","name":"Halted"}`)).
+				RespondWithStatus(503, []byte(`{"error":"Service Unavailable","code":"SoftLayer_Exception"}`)).
+				RespondWithFixture("SoftLayer_Virtual_Guest_Service_getPowerState.json")
+
+			vgPowerState, err := virtualGuestService.GetPowerState(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vgPowerState.KeyName).To(Equal("HALTED"))
+
+			_, err = virtualGuestService.GetPowerState(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+
+			for i := 0; i < 2; i++ {
+				vgPowerState, err = virtualGuestService.GetPowerState(virtualGuest.Id)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vgPowerState.KeyName).To(Equal("RUNNING"))
+			}
+
+			Expect(fakeClient.FakeHttpClient.CallsTo("GET", `SoftLayer_Virtual_Guest/\d+/getPowerState\.json`)).To(HaveLen(4))
+		})
+
 		Context("when HTTP client returns error codes 40x or 50x", func() {
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
@@ -1838,7 +2042,7 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 					_, err := virtualGuestService.GetNetworkComponents(virtualGuest.Id)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getNetworkComponents failed, HTTP error code: '%d'", errorCode)))
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getNetworkComponents, HTTP error code: '%d'", errorCode)))
 				}
 			})
 
@@ -1849,7 +2053,7 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 					_, err := virtualGuestService.GetNetworkComponents(virtualGuest.Id)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getNetworkComponents failed, HTTP error code: '%d'", errorCode)))
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getNetworkComponents, HTTP error code: '%d'", errorCode)))
 				}
 			})
 		})
@@ -1889,7 +2093,7 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 					_, err := virtualGuestService.GetPrimaryNetworkComponent(virtualGuest.Id)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getPrimaryNetworkComponent failed, HTTP error code: '%d'", errorCode)))
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPrimaryNetworkComponent, HTTP error code: '%d'", errorCode)))
 				}
 			})
 
@@ -1900,7 +2104,7 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 					_, err := virtualGuestService.GetPrimaryNetworkComponent(virtualGuest.Id)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getPrimaryNetworkComponent failed, HTTP error code: '%d'", errorCode)))
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPrimaryNetworkComponent, HTTP error code: '%d'", errorCode)))
 				}
 			})
 		})
@@ -1940,7 +2144,7 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 					_, err := virtualGuestService.GetPrimaryBackendNetworkComponent(virtualGuest.Id)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getPrimaryBackendNetworkComponent failed, HTTP error code: '%d'", errorCode)))
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPrimaryBackendNetworkComponent, HTTP error code: '%d'", errorCode)))
 				}
 			})
 
@@ -1951,7 +2155,7 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 					_, err := virtualGuestService.GetPrimaryBackendNetworkComponent(virtualGuest.Id)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: SoftLayer_Virtual_Guest#getPrimaryBackendNetworkComponent failed, HTTP error code: '%d'", errorCode)))
+					Expect(err).To(MatchError(fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getPrimaryBackendNetworkComponent, HTTP error code: '%d'", errorCode)))
 				}
 			})
 		})
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	POWER_STATE_RUNNING = "RUNNING"
+	POWER_STATE_HALTED  = "HALTED"
+)
+
+// WaitOptions configure the polling of a Waiter, the zero values use the defaults
+type WaitOptions struct {
+	PollingInterval time.Duration
+	Timeout         time.Duration
+
+	// TransactionGroups restricts the transactions waited for to these groups (e.g. "Reload Operating System"), all when empty
+	TransactionGroups []string
+
+	// OnProgress is called after each poll
+	OnProgress func(WaitProgress)
+}
+
+type WaitProgress struct {
+	Id      int
+	Attempt int
+	Elapsed time.Duration
+
+	// Transactions are the active transactions matching the TransactionGroups of the options
+	Transactions []datatypes.SoftLayer_Provisioning_Version1_Transaction
+	PowerState   string
+}
+
+// Waiter polls the active transactions and the power state of a virtual guest or a hardware
+type Waiter struct {
+	name string
+
+	activeTransactions func(ctx context.Context, id int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	powerState         func(ctx context.Context, id int) (string, error)
+}
+
+func NewVirtualGuestWaiter(virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service) *Waiter {
+	return &Waiter{
+		name: "virtual guest",
+
+		activeTransactions: virtualGuestService.GetActiveTransactionsWithContext,
+		powerState: func(ctx context.Context, id int) (string, error) {
+			powerState, err := virtualGuestService.GetPowerStateWithContext(ctx, id)
+			if err != nil {
+				return "", err
+			}
+
+			return powerState.KeyName, nil
+		},
+	}
+}
+
+// NewHardwareWaiter returns a Waiter for hardware, its "on" and "off" server power states are reported as RUNNING and HALTED
+func NewHardwareWaiter(hardwareService softlayer.SoftLayer_Hardware_Service) *Waiter {
+	return &Waiter{
+		name: "hardware",
+
+		activeTransactions: hardwareService.GetActiveTransactionsWithContext,
+		powerState: func(ctx context.Context, id int) (string, error) {
+			powerState, err := hardwareService.GetServerPowerStateWithContext(ctx, id)
+			if err != nil {
+				return "", err
+			}
+
+			switch strings.ToLower(powerState) {
+			case "on":
+				return POWER_STATE_RUNNING, nil
+			case "off":
+				return POWER_STATE_HALTED, nil
+			}
+
+			return strings.ToUpper(powerState), nil
+		},
+	}
+}
+
+// WaitForTransactions waits until there are no active transactions left in the TransactionGroups of the options
+func (w *Waiter) WaitForTransactions(ctx context.Context, id int, opts WaitOptions) error {
+	return w.poll(ctx, id, opts, "to have no active transactions", func(ctx context.Context, progress *WaitProgress) (bool, error) {
+		transactions, err := w.matchingTransactions(ctx, id, opts.TransactionGroups)
+		if err != nil {
+			return false, err
+		}
+
+		progress.Transactions = transactions
+		return len(transactions) == 0, nil
+	})
+}
+
+// WaitForPowerState waits until the power state is powerState (e.g. RUNNING or HALTED), compared case insensitively
+func (w *Waiter) WaitForPowerState(ctx context.Context, id int, powerState string, opts WaitOptions) error {
+	return w.poll(ctx, id, opts, fmt.Sprintf("to be %s", powerState), func(ctx context.Context, progress *WaitProgress) (bool, error) {
+		currentPowerState, err := w.powerState(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		progress.PowerState = currentPowerState
+		return strings.EqualFold(currentPowerState, powerState), nil
+	})
+}
+
+// WaitUntilReady waits until there are no active transactions left in the TransactionGroups of the options and the power state is RUNNING
+func (w *Waiter) WaitUntilReady(ctx context.Context, id int, opts WaitOptions) error {
+	return w.poll(ctx, id, opts, "to be ready", func(ctx context.Context, progress *WaitProgress) (bool, error) {
+		transactions, err := w.matchingTransactions(ctx, id, opts.TransactionGroups)
+		if err != nil {
+			return false, err
+		}
+
+		progress.Transactions = transactions
+		if len(transactions) > 0 {
+			return false, nil
+		}
+
+		powerState, err := w.powerState(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		progress.PowerState = powerState
+		return strings.EqualFold(powerState, POWER_STATE_RUNNING), nil
+	})
+}
+
+//Private methods
+
+func (w *Waiter) poll(ctx context.Context, id int, opts WaitOptions, condition string, check func(ctx context.Context, progress *WaitProgress) (bool, error)) error {
+	return poll(ctx, opts.PollingInterval, opts.Timeout, fmt.Sprintf("%s '%d' %s", w.name, id, condition), func(ctx context.Context, attempt int, start time.Time) (bool, error) {
+		progress := WaitProgress{
+			Id:      id,
+			Attempt: attempt,
+		}
+
+		done, err := check(ctx, &progress)
+		if err != nil {
+			return false, err
+		}
+
+		progress.Elapsed = time.Since(start)
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+
+		return done, nil
+	})
+}
+
+func (w *Waiter) matchingTransactions(ctx context.Context, id int, transactionGroups []string) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	transactions, err := w.activeTransactions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transactionGroups) == 0 {
+		return transactions, nil
+	}
+
+	matching := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	for _, transaction := range transactions {
+		for _, transactionGroup := range transactionGroups {
+			if strings.EqualFold(transaction.TransactionGroup.Name, transactionGroup) {
+				matching = append(matching, transaction)
+				break
+			}
+		}
+	}
+
+	return matching, nil
+}
@@ -0,0 +1,189 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
+	softlayerfakes "github.com/maximilien/softlayer-go/softlayer/fakes"
+)
+
+var _ = Describe("Waiter", func() {
+	var (
+		fakeVirtualGuestService *softlayerfakes.FakeSoftLayer_Virtual_Guest_Service
+		fakeHardwareService     *softlayerfakes.FakeSoftLayer_Hardware_Service
+
+		waiter *services.Waiter
+		opts   services.WaitOptions
+
+		progresses []services.WaitProgress
+
+		reloadTransaction, backupTransaction datatypes.SoftLayer_Provisioning_Version1_Transaction
+	)
+
+	BeforeEach(func() {
+		fakeVirtualGuestService = &softlayerfakes.FakeSoftLayer_Virtual_Guest_Service{}
+		fakeHardwareService = &softlayerfakes.FakeSoftLayer_Hardware_Service{}
+
+		waiter = services.NewVirtualGuestWaiter(fakeVirtualGuestService)
+
+		progresses = []services.WaitProgress{}
+		opts = services.WaitOptions{
+			PollingInterval: time.Millisecond,
+			Timeout:         time.Second,
+			OnProgress: func(progress services.WaitProgress) {
+				progresses = append(progresses, progress)
+			},
+		}
+
+		reloadTransaction = datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			Id:               1,
+			TransactionGroup: datatypes.TransactionGroup{Name: "Reload Operating System"},
+		}
+		backupTransaction = datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			Id:               2,
+			TransactionGroup: datatypes.TransactionGroup{Name: "Image Backup"},
+		}
+	})
+
+	Context("#WaitForTransactions", func() {
+		It("polls until there are no active transactions", func() {
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturnsOnCall(0, []datatypes.SoftLayer_Provisioning_Version1_Transaction{reloadTransaction, backupTransaction}, nil)
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturnsOnCall(1, []datatypes.SoftLayer_Provisioning_Version1_Transaction{backupTransaction}, nil)
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturnsOnCall(2, []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, nil)
+
+			err := waiter.WaitForTransactions(context.Background(), 1234567, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeVirtualGuestService.GetActiveTransactionsWithContextCallCount()).To(Equal(3))
+			_, id := fakeVirtualGuestService.GetActiveTransactionsWithContextArgsForCall(0)
+			Expect(id).To(Equal(1234567))
+
+			Expect(progresses).To(HaveLen(3))
+			Expect(progresses[0].Id).To(Equal(1234567))
+			Expect(progresses[0].Attempt).To(Equal(1))
+			Expect(progresses[0].Transactions).To(HaveLen(2))
+			Expect(progresses[1].Transactions).To(HaveLen(1))
+			Expect(progresses[2].Attempt).To(Equal(3))
+			Expect(progresses[2].Transactions).To(BeEmpty())
+		})
+
+		It("only waits for the transactions of the transaction groups", func() {
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturnsOnCall(0, []datatypes.SoftLayer_Provisioning_Version1_Transaction{reloadTransaction, backupTransaction}, nil)
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturnsOnCall(1, []datatypes.SoftLayer_Provisioning_Version1_Transaction{backupTransaction}, nil)
+
+			opts.TransactionGroups = []string{"reload operating system"}
+
+			err := waiter.WaitForTransactions(context.Background(), 1234567, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeVirtualGuestService.GetActiveTransactionsWithContextCallCount()).To(Equal(2))
+			Expect(progresses[0].Transactions).To(Equal([]datatypes.SoftLayer_Provisioning_Version1_Transaction{reloadTransaction}))
+			Expect(progresses[1].Transactions).To(BeEmpty())
+		})
+
+		It("times out when the transactions do not complete", func() {
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturns([]datatypes.SoftLayer_Provisioning_Version1_Transaction{reloadTransaction}, nil)
+
+			opts.Timeout = 20 * time.Millisecond
+
+			err := waiter.WaitForTransactions(context.Background(), 1234567, opts)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timed out after 20ms waiting for virtual guest '1234567' to have no active transactions"))
+		})
+
+		It("returns the error of the context when it is canceled", func() {
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturns([]datatypes.SoftLayer_Provisioning_Version1_Transaction{reloadTransaction}, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			opts.OnProgress = func(services.WaitProgress) {
+				cancel()
+			}
+
+			err := waiter.WaitForTransactions(ctx, 1234567, opts)
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("fails when getting the active transactions fails", func() {
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturns(nil, errors.New("fake-error"))
+
+			err := waiter.WaitForTransactions(context.Background(), 1234567, opts)
+			Expect(err).To(MatchError("fake-error"))
+			Expect(progresses).To(BeEmpty())
+		})
+	})
+
+	Context("#WaitForPowerState", func() {
+		It("polls until the virtual guest has the power state", func() {
+			fakeVirtualGuestService.GetPowerStateWithContextReturnsOnCall(0, datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "HALTED"}, nil)
+			fakeVirtualGuestService.GetPowerStateWithContextReturnsOnCall(1, datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "RUNNING"}, nil)
+
+			err := waiter.WaitForPowerState(context.Background(), 1234567, "running", opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeVirtualGuestService.GetPowerStateWithContextCallCount()).To(Equal(2))
+			Expect(progresses).To(HaveLen(2))
+			Expect(progresses[0].PowerState).To(Equal("HALTED"))
+			Expect(progresses[1].PowerState).To(Equal("RUNNING"))
+		})
+
+		It("reports the hardware server power states as RUNNING and HALTED", func() {
+			fakeHardwareService.GetServerPowerStateWithContextReturnsOnCall(0, "off", nil)
+			fakeHardwareService.GetServerPowerStateWithContextReturnsOnCall(1, "on", nil)
+
+			err := services.NewHardwareWaiter(fakeHardwareService).WaitForPowerState(context.Background(), 1234567, services.POWER_STATE_RUNNING, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeHardwareService.GetServerPowerStateWithContextCallCount()).To(Equal(2))
+			Expect(progresses[0].PowerState).To(Equal("HALTED"))
+			Expect(progresses[1].PowerState).To(Equal("RUNNING"))
+		})
+
+		It("times out when the power state is not reached", func() {
+			fakeHardwareService.GetServerPowerStateWithContextReturns("off", nil)
+
+			opts.Timeout = 20 * time.Millisecond
+
+			err := services.NewHardwareWaiter(fakeHardwareService).WaitForPowerState(context.Background(), 1234567, services.POWER_STATE_RUNNING, opts)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("waiting for hardware '1234567' to be RUNNING"))
+		})
+	})
+
+	Context("#WaitUntilReady", func() {
+		It("waits for the active transactions and then the RUNNING power state", func() {
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturnsOnCall(0, []datatypes.SoftLayer_Provisioning_Version1_Transaction{reloadTransaction}, nil)
+			fakeVirtualGuestService.GetActiveTransactionsWithContextReturns([]datatypes.SoftLayer_Provisioning_Version1_Transaction{}, nil)
+			fakeVirtualGuestService.GetPowerStateWithContextReturnsOnCall(0, datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "HALTED"}, nil)
+			fakeVirtualGuestService.GetPowerStateWithContextReturnsOnCall(1, datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "RUNNING"}, nil)
+
+			err := waiter.WaitUntilReady(context.Background(), 1234567, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeVirtualGuestService.GetActiveTransactionsWithContextCallCount()).To(Equal(3))
+			Expect(fakeVirtualGuestService.GetPowerStateWithContextCallCount()).To(Equal(2))
+			Expect(progresses).To(HaveLen(3))
+			Expect(progresses[0].Transactions).To(HaveLen(1))
+			Expect(progresses[0].PowerState).To(BeEmpty())
+			Expect(progresses[2].PowerState).To(Equal("RUNNING"))
+		})
+
+		It("waits until the hardware is ready", func() {
+			fakeHardwareService.GetActiveTransactionsWithContextReturns([]datatypes.SoftLayer_Provisioning_Version1_Transaction{backupTransaction}, nil)
+			fakeHardwareService.GetServerPowerStateWithContextReturns("on", nil)
+
+			opts.TransactionGroups = []string{"Reload Operating System"}
+
+			err := services.NewHardwareWaiter(fakeHardwareService).WaitUntilReady(context.Background(), 1234567, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeHardwareService.GetActiveTransactionsWithContextCallCount()).To(Equal(1))
+			Expect(fakeHardwareService.GetServerPowerStateWithContextCallCount()).To(Equal(1))
+		})
+	})
+})
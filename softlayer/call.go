@@ -0,0 +1,41 @@
+package softlayer
+
+import (
+	"context"
+	"encoding/json"
+
+	common "github.com/maximilien/softlayer-go/common"
+)
+
+// Call sends a method of a service with the HTTP client of the client and decodes its result into result (when not
+// nil), e.g. for the methods softlayer-go does not wrap yet:
+//
+//	tickets := []map[string]interface{}{}
+//	err := softlayer.Call(client, "SoftLayer_Account", "getOpenTickets", 0, nil, &tickets)
+//
+// The id is left out of the path when 0.
+func Call(client Client, service string, method string, id int, parameters []interface{}, result interface{}) error {
+	return CallWithContext(context.Background(), client, service, method, id, parameters, result)
+}
+
+func CallWithContext(ctx context.Context, client Client, service string, method string, id int, parameters []interface{}, result interface{}) error {
+	return CallRequest(ctx, client, NewRequest(service, method).WithId(id).WithParameters(parameters...), result)
+}
+
+// CallRequest is Call for a request, e.g. with an object mask or filter
+func CallRequest(ctx context.Context, client Client, request *Request, result interface{}) error {
+	response, errorCode, err := client.GetHttpClient().DoRequestWithContext(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		return common.NewSoftLayerError(request.Service, request.Method, errorCode, response)
+	}
+
+	if result == nil || len(response) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(response, result)
+}
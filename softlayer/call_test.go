@@ -0,0 +1,98 @@
+package softlayer_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	common "github.com/maximilien/softlayer-go/common"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	softlayer_fakes "github.com/maximilien/softlayer-go/softlayer/fakes"
+)
+
+var _ = Describe("Call", func() {
+	var (
+		fakeClient     *softlayer_fakes.FakeClient
+		fakeHttpClient *softlayer_fakes.FakeHttpClient
+	)
+
+	BeforeEach(func() {
+		fakeClient = softlayer_fakes.NewFakeClient()
+		fakeHttpClient = fakeClient.HttpClient()
+	})
+
+	Context(".Call", func() {
+		It("sends the method of the object and decodes the result", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte(`{"id": 1234, "name": "fake-name"}`), 200, nil)
+
+			status := struct {
+				Id   int    `json:"id"`
+				Name string `json:"name"`
+			}{}
+			err := softlayer.Call(fakeClient, "SoftLayer_Ticket", "getStatus", 1234, nil, &status)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Id).To(Equal(1234))
+			Expect(status.Name).To(Equal("fake-name"))
+
+			_, request := fakeHttpClient.DoRequestWithContextArgsForCall(0)
+			Expect(request.Path()).To(Equal("SoftLayer_Ticket/1234/getStatus.json"))
+			Expect(request.Verb()).To(Equal("GET"))
+		})
+
+		It("sends the parameters", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte("true"), 200, nil)
+
+			var removed bool
+			err := softlayer.Call(fakeClient, "SoftLayer_Ticket", "removeAttachedHardware", 1234, []interface{}{5678}, &removed)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeTrue())
+
+			_, request := fakeHttpClient.DoRequestWithContextArgsForCall(0)
+			Expect(request.Verb()).To(Equal("POST"))
+
+			body, err := request.Body()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.String()).To(MatchJSON(`{"parameters": [5678]}`))
+		})
+
+		It("ignores the result when nil", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte("null"), 200, nil)
+
+			err := softlayer.Call(fakeClient, "SoftLayer_Ticket", "markAsViewed", 1234, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails for error codes 40x and 50x", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte(`{"error": "fake-error", "code": "SoftLayer_Exception_ObjectNotFound"}`), 404, nil)
+
+			err := softlayer.Call(fakeClient, "SoftLayer_Ticket", "getObject", 1234, nil, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(common.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("fails when the request fails", func() {
+			fakeHttpClient.DoRequestWithContextReturns(nil, 0, errors.New("fake-error"))
+
+			err := softlayer.Call(fakeClient, "SoftLayer_Ticket", "getObject", 1234, nil, nil)
+			Expect(err).To(MatchError("fake-error"))
+		})
+	})
+
+	Context(".CallRequest", func() {
+		It("sends the request with its mask and context", func() {
+			fakeHttpClient.DoRequestWithContextReturns([]byte(`{}`), 200, nil)
+
+			ctx := context.WithValue(context.Background(), "fake-key", "fake-value")
+			request := softlayer.NewRequest("SoftLayer_Ticket", "getObject").WithId(1234).WithMask("id", "title")
+
+			err := softlayer.CallRequest(ctx, fakeClient, request, &map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sentCtx, sentRequest := fakeHttpClient.DoRequestWithContextArgsForCall(0)
+			Expect(sentCtx).To(Equal(ctx))
+			Expect(sentRequest.ObjectMask()).To(Equal("mask[id;title]"))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package softlayer
 
 import (
 	"bytes"
+	"context"
 )
 
 type Client interface {
@@ -22,6 +23,8 @@ type Client interface {
 	GetSoftLayer_Dns_Domain_Service() (SoftLayer_Dns_Domain_Service, error)
 	GetSoftLayer_Dns_Domain_ResourceRecord_Service() (SoftLayer_Dns_Domain_ResourceRecord_Service, error)
 
+	GeneratedServices
+
 	GetHttpClient() HttpClient
 }
 
@@ -30,6 +33,19 @@ type HttpClient interface {
 	DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
 	DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
 	DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
+
+	DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
+	DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
+	DoRawHttpRequestWithObjectFilterWithContext(ctx context.Context, path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
+	DoRawHttpRequestWithObjectFilterAndObjectMaskWithContext(ctx context.Context, path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error)
+
+	DoRequest(request *Request) ([]byte, int, error)
+	DoRequestWithContext(ctx context.Context, request *Request) ([]byte, int, error)
+
+	//Same as DoRequest, also returning the SoftLayer-Total-Items header of the response (-1 when missing)
+	DoPagedRequest(request *Request) ([]byte, int, int, error)
+	DoPagedRequestWithContext(ctx context.Context, request *Request) ([]byte, int, int, error)
+
 	GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error)
 	HasErrors(body map[string]interface{}) error
 
@@ -0,0 +1,1582 @@
+// Code generated by softlayer/fakes/generate.go. DO NOT EDIT.
+
+package softlayer_fakes
+
+import (
+	"sync"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type FakeClient struct {
+	GetHttpClientStub        func() softlayer.HttpClient
+	getHttpClientMutex       sync.RWMutex
+	getHttpClientArgsForCall []struct {
+	}
+	getHttpClientReturns struct {
+		result1 softlayer.HttpClient
+	}
+	getHttpClientReturnsOnCall map[int]struct {
+		result1 softlayer.HttpClient
+	}
+	GetServiceStub        func(string) (softlayer.Service, error)
+	getServiceMutex       sync.RWMutex
+	getServiceArgsForCall []struct {
+		arg1 string
+	}
+	getServiceReturns struct {
+		result1 softlayer.Service
+		result2 error
+	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 softlayer.Service
+		result2 error
+	}
+	GetSoftLayer_Account_ServiceStub        func() (softlayer.SoftLayer_Account_Service, error)
+	getSoftLayer_Account_ServiceMutex       sync.RWMutex
+	getSoftLayer_Account_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Account_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Account_Service
+		result2 error
+	}
+	getSoftLayer_Account_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Account_Service
+		result2 error
+	}
+	GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub        func() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error)
+	getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex       sync.RWMutex
+	getSoftLayer_Billing_Item_Cancellation_Request_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service
+		result2 error
+	}
+	getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service
+		result2 error
+	}
+	GetSoftLayer_Billing_Item_ServiceStub        func() (softlayer.SoftLayer_Billing_Item_Service, error)
+	getSoftLayer_Billing_Item_ServiceMutex       sync.RWMutex
+	getSoftLayer_Billing_Item_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Billing_Item_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Billing_Item_Service
+		result2 error
+	}
+	getSoftLayer_Billing_Item_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Billing_Item_Service
+		result2 error
+	}
+	GetSoftLayer_Billing_Order_ServiceStub        func() (softlayer.SoftLayer_Billing_Order_Service, error)
+	getSoftLayer_Billing_Order_ServiceMutex       sync.RWMutex
+	getSoftLayer_Billing_Order_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Billing_Order_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Billing_Order_Service
+		result2 error
+	}
+	getSoftLayer_Billing_Order_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Billing_Order_Service
+		result2 error
+	}
+	GetSoftLayer_Dns_Domain_ResourceRecord_ServiceStub        func() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, error)
+	getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex       sync.RWMutex
+	getSoftLayer_Dns_Domain_ResourceRecord_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service
+		result2 error
+	}
+	getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service
+		result2 error
+	}
+	GetSoftLayer_Dns_Domain_ServiceStub        func() (softlayer.SoftLayer_Dns_Domain_Service, error)
+	getSoftLayer_Dns_Domain_ServiceMutex       sync.RWMutex
+	getSoftLayer_Dns_Domain_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Dns_Domain_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Dns_Domain_Service
+		result2 error
+	}
+	getSoftLayer_Dns_Domain_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Dns_Domain_Service
+		result2 error
+	}
+	GetSoftLayer_Hardware_ServiceStub        func() (softlayer.SoftLayer_Hardware_Service, error)
+	getSoftLayer_Hardware_ServiceMutex       sync.RWMutex
+	getSoftLayer_Hardware_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Hardware_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Hardware_Service
+		result2 error
+	}
+	getSoftLayer_Hardware_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Hardware_Service
+		result2 error
+	}
+	GetSoftLayer_Location_Datacenter_ServiceStub        func() (softlayer.SoftLayer_Location_Datacenter_Service, error)
+	getSoftLayer_Location_Datacenter_ServiceMutex       sync.RWMutex
+	getSoftLayer_Location_Datacenter_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Location_Datacenter_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Location_Datacenter_Service
+		result2 error
+	}
+	getSoftLayer_Location_Datacenter_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Location_Datacenter_Service
+		result2 error
+	}
+	GetSoftLayer_Location_ServiceStub        func() (softlayer.SoftLayer_Location_Service, error)
+	getSoftLayer_Location_ServiceMutex       sync.RWMutex
+	getSoftLayer_Location_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Location_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Location_Service
+		result2 error
+	}
+	getSoftLayer_Location_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Location_Service
+		result2 error
+	}
+	GetSoftLayer_Network_Storage_Allowed_Host_ServiceStub        func() (softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, error)
+	getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex       sync.RWMutex
+	getSoftLayer_Network_Storage_Allowed_Host_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Network_Storage_Allowed_Host_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service
+		result2 error
+	}
+	getSoftLayer_Network_Storage_Allowed_Host_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service
+		result2 error
+	}
+	GetSoftLayer_Network_Storage_ServiceStub        func() (softlayer.SoftLayer_Network_Storage_Service, error)
+	getSoftLayer_Network_Storage_ServiceMutex       sync.RWMutex
+	getSoftLayer_Network_Storage_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Network_Storage_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Network_Storage_Service
+		result2 error
+	}
+	getSoftLayer_Network_Storage_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Network_Storage_Service
+		result2 error
+	}
+	GetSoftLayer_Product_Order_ServiceStub        func() (softlayer.SoftLayer_Product_Order_Service, error)
+	getSoftLayer_Product_Order_ServiceMutex       sync.RWMutex
+	getSoftLayer_Product_Order_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Product_Order_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Product_Order_Service
+		result2 error
+	}
+	getSoftLayer_Product_Order_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Product_Order_Service
+		result2 error
+	}
+	GetSoftLayer_Product_Package_ServiceStub        func() (softlayer.SoftLayer_Product_Package_Service, error)
+	getSoftLayer_Product_Package_ServiceMutex       sync.RWMutex
+	getSoftLayer_Product_Package_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Product_Package_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Product_Package_Service
+		result2 error
+	}
+	getSoftLayer_Product_Package_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Product_Package_Service
+		result2 error
+	}
+	GetSoftLayer_Security_Ssh_Key_ServiceStub        func() (softlayer.SoftLayer_Security_Ssh_Key_Service, error)
+	getSoftLayer_Security_Ssh_Key_ServiceMutex       sync.RWMutex
+	getSoftLayer_Security_Ssh_Key_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Security_Ssh_Key_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Security_Ssh_Key_Service
+		result2 error
+	}
+	getSoftLayer_Security_Ssh_Key_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Security_Ssh_Key_Service
+		result2 error
+	}
+	GetSoftLayer_Ticket_ServiceStub        func() (softlayer.SoftLayer_Ticket_Service, error)
+	getSoftLayer_Ticket_ServiceMutex       sync.RWMutex
+	getSoftLayer_Ticket_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Ticket_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Ticket_Service
+		result2 error
+	}
+	getSoftLayer_Ticket_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Ticket_Service
+		result2 error
+	}
+	GetSoftLayer_Ticket_Status_ServiceStub        func() (softlayer.SoftLayer_Ticket_Status_Service, error)
+	getSoftLayer_Ticket_Status_ServiceMutex       sync.RWMutex
+	getSoftLayer_Ticket_Status_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Ticket_Status_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Ticket_Status_Service
+		result2 error
+	}
+	getSoftLayer_Ticket_Status_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Ticket_Status_Service
+		result2 error
+	}
+	GetSoftLayer_Ticket_Subject_ServiceStub        func() (softlayer.SoftLayer_Ticket_Subject_Service, error)
+	getSoftLayer_Ticket_Subject_ServiceMutex       sync.RWMutex
+	getSoftLayer_Ticket_Subject_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Ticket_Subject_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Ticket_Subject_Service
+		result2 error
+	}
+	getSoftLayer_Ticket_Subject_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Ticket_Subject_Service
+		result2 error
+	}
+	GetSoftLayer_Virtual_Disk_Image_ServiceStub        func() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error)
+	getSoftLayer_Virtual_Disk_Image_ServiceMutex       sync.RWMutex
+	getSoftLayer_Virtual_Disk_Image_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Virtual_Disk_Image_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Virtual_Disk_Image_Service
+		result2 error
+	}
+	getSoftLayer_Virtual_Disk_Image_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Virtual_Disk_Image_Service
+		result2 error
+	}
+	GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub        func() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error)
+	getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex       sync.RWMutex
+	getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+		result2 error
+	}
+	getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+		result2 error
+	}
+	GetSoftLayer_Virtual_Guest_ServiceStub        func() (softlayer.SoftLayer_Virtual_Guest_Service, error)
+	getSoftLayer_Virtual_Guest_ServiceMutex       sync.RWMutex
+	getSoftLayer_Virtual_Guest_ServiceArgsForCall []struct {
+	}
+	getSoftLayer_Virtual_Guest_ServiceReturns struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Service
+		result2 error
+	}
+	getSoftLayer_Virtual_Guest_ServiceReturnsOnCall map[int]struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Service
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) GetHttpClient() softlayer.HttpClient {
+	fake.getHttpClientMutex.Lock()
+	ret, specificReturn := fake.getHttpClientReturnsOnCall[len(fake.getHttpClientArgsForCall)]
+	fake.getHttpClientArgsForCall = append(fake.getHttpClientArgsForCall, struct {
+	}{})
+	stub := fake.GetHttpClientStub
+	fakeReturns := fake.getHttpClientReturns
+	fake.recordInvocation("GetHttpClient", []interface{}{})
+	fake.getHttpClientMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) GetHttpClientCallCount() int {
+	fake.getHttpClientMutex.RLock()
+	defer fake.getHttpClientMutex.RUnlock()
+	return len(fake.getHttpClientArgsForCall)
+}
+
+func (fake *FakeClient) GetHttpClientCalls(stub func() softlayer.HttpClient) {
+	fake.getHttpClientMutex.Lock()
+	defer fake.getHttpClientMutex.Unlock()
+	fake.GetHttpClientStub = stub
+}
+
+func (fake *FakeClient) GetHttpClientReturns(result1 softlayer.HttpClient) {
+	fake.getHttpClientMutex.Lock()
+	defer fake.getHttpClientMutex.Unlock()
+	fake.GetHttpClientStub = nil
+	fake.getHttpClientReturns = struct {
+		result1 softlayer.HttpClient
+	}{result1}
+}
+
+func (fake *FakeClient) GetHttpClientReturnsOnCall(i int, result1 softlayer.HttpClient) {
+	fake.getHttpClientMutex.Lock()
+	defer fake.getHttpClientMutex.Unlock()
+	fake.GetHttpClientStub = nil
+	if fake.getHttpClientReturnsOnCall == nil {
+		fake.getHttpClientReturnsOnCall = make(map[int]struct {
+			result1 softlayer.HttpClient
+		})
+	}
+	fake.getHttpClientReturnsOnCall[i] = struct {
+		result1 softlayer.HttpClient
+	}{result1}
+}
+
+func (fake *FakeClient) GetService(arg1 string) (softlayer.Service, error) {
+	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
+	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceStub
+	fakeReturns := fake.getServiceReturns
+	fake.recordInvocation("GetService", []interface{}{arg1})
+	fake.getServiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetServiceCallCount() int {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return len(fake.getServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetServiceCalls(stub func(string) (softlayer.Service, error)) {
+	fake.getServiceMutex.Lock()
+	defer fake.getServiceMutex.Unlock()
+	fake.GetServiceStub = stub
+}
+
+func (fake *FakeClient) GetServiceArgsForCall(i int) string {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	argsForCall := fake.getServiceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) GetServiceReturns(result1 softlayer.Service, result2 error) {
+	fake.getServiceMutex.Lock()
+	defer fake.getServiceMutex.Unlock()
+	fake.GetServiceStub = nil
+	fake.getServiceReturns = struct {
+		result1 softlayer.Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetServiceReturnsOnCall(i int, result1 softlayer.Service, result2 error) {
+	fake.getServiceMutex.Lock()
+	defer fake.getServiceMutex.Unlock()
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.Service
+			result2 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 softlayer.Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Account_Service() (softlayer.SoftLayer_Account_Service, error) {
+	fake.getSoftLayer_Account_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Account_ServiceReturnsOnCall[len(fake.getSoftLayer_Account_ServiceArgsForCall)]
+	fake.getSoftLayer_Account_ServiceArgsForCall = append(fake.getSoftLayer_Account_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Account_ServiceStub
+	fakeReturns := fake.getSoftLayer_Account_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Account_Service", []interface{}{})
+	fake.getSoftLayer_Account_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Account_ServiceCallCount() int {
+	fake.getSoftLayer_Account_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Account_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Account_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Account_ServiceCalls(stub func() (softlayer.SoftLayer_Account_Service, error)) {
+	fake.getSoftLayer_Account_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Account_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Account_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Account_ServiceReturns(result1 softlayer.SoftLayer_Account_Service, result2 error) {
+	fake.getSoftLayer_Account_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Account_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Account_ServiceStub = nil
+	fake.getSoftLayer_Account_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Account_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Account_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Account_Service, result2 error) {
+	fake.getSoftLayer_Account_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Account_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Account_ServiceStub = nil
+	if fake.getSoftLayer_Account_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Account_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Account_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Account_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Account_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Cancellation_Request_Service() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error) {
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturnsOnCall[len(fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceArgsForCall)]
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceArgsForCall = append(fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub
+	fakeReturns := fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Billing_Item_Cancellation_Request_Service", []interface{}{})
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Cancellation_Request_ServiceCallCount() int {
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Cancellation_Request_ServiceCalls(stub func() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error)) {
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Cancellation_Request_ServiceReturns(result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, result2 error) {
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub = nil
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Cancellation_Request_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, result2 error) {
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub = nil
+	if fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Service() (softlayer.SoftLayer_Billing_Item_Service, error) {
+	fake.getSoftLayer_Billing_Item_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Billing_Item_ServiceReturnsOnCall[len(fake.getSoftLayer_Billing_Item_ServiceArgsForCall)]
+	fake.getSoftLayer_Billing_Item_ServiceArgsForCall = append(fake.getSoftLayer_Billing_Item_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Billing_Item_ServiceStub
+	fakeReturns := fake.getSoftLayer_Billing_Item_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Billing_Item_Service", []interface{}{})
+	fake.getSoftLayer_Billing_Item_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_ServiceCallCount() int {
+	fake.getSoftLayer_Billing_Item_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Item_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Billing_Item_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_ServiceCalls(stub func() (softlayer.SoftLayer_Billing_Item_Service, error)) {
+	fake.getSoftLayer_Billing_Item_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Item_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Item_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_ServiceReturns(result1 softlayer.SoftLayer_Billing_Item_Service, result2 error) {
+	fake.getSoftLayer_Billing_Item_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Item_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Item_ServiceStub = nil
+	fake.getSoftLayer_Billing_Item_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Billing_Item_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Billing_Item_Service, result2 error) {
+	fake.getSoftLayer_Billing_Item_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Item_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Item_ServiceStub = nil
+	if fake.getSoftLayer_Billing_Item_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Billing_Item_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Billing_Item_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Billing_Item_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Billing_Item_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Order_Service() (softlayer.SoftLayer_Billing_Order_Service, error) {
+	fake.getSoftLayer_Billing_Order_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Billing_Order_ServiceReturnsOnCall[len(fake.getSoftLayer_Billing_Order_ServiceArgsForCall)]
+	fake.getSoftLayer_Billing_Order_ServiceArgsForCall = append(fake.getSoftLayer_Billing_Order_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Billing_Order_ServiceStub
+	fakeReturns := fake.getSoftLayer_Billing_Order_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Billing_Order_Service", []interface{}{})
+	fake.getSoftLayer_Billing_Order_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Order_ServiceCallCount() int {
+	fake.getSoftLayer_Billing_Order_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Order_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Billing_Order_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Order_ServiceCalls(stub func() (softlayer.SoftLayer_Billing_Order_Service, error)) {
+	fake.getSoftLayer_Billing_Order_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Order_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Order_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Order_ServiceReturns(result1 softlayer.SoftLayer_Billing_Order_Service, result2 error) {
+	fake.getSoftLayer_Billing_Order_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Order_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Order_ServiceStub = nil
+	fake.getSoftLayer_Billing_Order_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Billing_Order_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Order_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Billing_Order_Service, result2 error) {
+	fake.getSoftLayer_Billing_Order_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Billing_Order_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Billing_Order_ServiceStub = nil
+	if fake.getSoftLayer_Billing_Order_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Billing_Order_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Billing_Order_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Billing_Order_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Billing_Order_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ResourceRecord_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, error) {
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturnsOnCall[len(fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceArgsForCall)]
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceArgsForCall = append(fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Dns_Domain_ResourceRecord_ServiceStub
+	fakeReturns := fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Dns_Domain_ResourceRecord_Service", []interface{}{})
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ResourceRecord_ServiceCallCount() int {
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ResourceRecord_ServiceCalls(stub func() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, error)) {
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Dns_Domain_ResourceRecord_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ResourceRecord_ServiceReturns(result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, result2 error) {
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Dns_Domain_ResourceRecord_ServiceStub = nil
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ResourceRecord_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, result2 error) {
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Dns_Domain_ResourceRecord_ServiceStub = nil
+	if fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_Service() (softlayer.SoftLayer_Dns_Domain_Service, error) {
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Dns_Domain_ServiceReturnsOnCall[len(fake.getSoftLayer_Dns_Domain_ServiceArgsForCall)]
+	fake.getSoftLayer_Dns_Domain_ServiceArgsForCall = append(fake.getSoftLayer_Dns_Domain_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Dns_Domain_ServiceStub
+	fakeReturns := fake.getSoftLayer_Dns_Domain_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Dns_Domain_Service", []interface{}{})
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ServiceCallCount() int {
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Dns_Domain_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Dns_Domain_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ServiceCalls(stub func() (softlayer.SoftLayer_Dns_Domain_Service, error)) {
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Dns_Domain_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Dns_Domain_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ServiceReturns(result1 softlayer.SoftLayer_Dns_Domain_Service, result2 error) {
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Dns_Domain_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Dns_Domain_ServiceStub = nil
+	fake.getSoftLayer_Dns_Domain_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Dns_Domain_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Dns_Domain_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Dns_Domain_Service, result2 error) {
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Dns_Domain_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Dns_Domain_ServiceStub = nil
+	if fake.getSoftLayer_Dns_Domain_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Dns_Domain_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Dns_Domain_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Dns_Domain_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Dns_Domain_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Hardware_Service() (softlayer.SoftLayer_Hardware_Service, error) {
+	fake.getSoftLayer_Hardware_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Hardware_ServiceReturnsOnCall[len(fake.getSoftLayer_Hardware_ServiceArgsForCall)]
+	fake.getSoftLayer_Hardware_ServiceArgsForCall = append(fake.getSoftLayer_Hardware_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Hardware_ServiceStub
+	fakeReturns := fake.getSoftLayer_Hardware_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Hardware_Service", []interface{}{})
+	fake.getSoftLayer_Hardware_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Hardware_ServiceCallCount() int {
+	fake.getSoftLayer_Hardware_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Hardware_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Hardware_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Hardware_ServiceCalls(stub func() (softlayer.SoftLayer_Hardware_Service, error)) {
+	fake.getSoftLayer_Hardware_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Hardware_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Hardware_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Hardware_ServiceReturns(result1 softlayer.SoftLayer_Hardware_Service, result2 error) {
+	fake.getSoftLayer_Hardware_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Hardware_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Hardware_ServiceStub = nil
+	fake.getSoftLayer_Hardware_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Hardware_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Hardware_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Hardware_Service, result2 error) {
+	fake.getSoftLayer_Hardware_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Hardware_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Hardware_ServiceStub = nil
+	if fake.getSoftLayer_Hardware_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Hardware_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Hardware_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Hardware_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Hardware_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_Datacenter_Service() (softlayer.SoftLayer_Location_Datacenter_Service, error) {
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Location_Datacenter_ServiceReturnsOnCall[len(fake.getSoftLayer_Location_Datacenter_ServiceArgsForCall)]
+	fake.getSoftLayer_Location_Datacenter_ServiceArgsForCall = append(fake.getSoftLayer_Location_Datacenter_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Location_Datacenter_ServiceStub
+	fakeReturns := fake.getSoftLayer_Location_Datacenter_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Location_Datacenter_Service", []interface{}{})
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_Datacenter_ServiceCallCount() int {
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Location_Datacenter_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Location_Datacenter_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_Datacenter_ServiceCalls(stub func() (softlayer.SoftLayer_Location_Datacenter_Service, error)) {
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Location_Datacenter_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Location_Datacenter_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_Datacenter_ServiceReturns(result1 softlayer.SoftLayer_Location_Datacenter_Service, result2 error) {
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Location_Datacenter_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Location_Datacenter_ServiceStub = nil
+	fake.getSoftLayer_Location_Datacenter_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Location_Datacenter_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_Datacenter_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Location_Datacenter_Service, result2 error) {
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Location_Datacenter_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Location_Datacenter_ServiceStub = nil
+	if fake.getSoftLayer_Location_Datacenter_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Location_Datacenter_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Location_Datacenter_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Location_Datacenter_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Location_Datacenter_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_Service() (softlayer.SoftLayer_Location_Service, error) {
+	fake.getSoftLayer_Location_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Location_ServiceReturnsOnCall[len(fake.getSoftLayer_Location_ServiceArgsForCall)]
+	fake.getSoftLayer_Location_ServiceArgsForCall = append(fake.getSoftLayer_Location_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Location_ServiceStub
+	fakeReturns := fake.getSoftLayer_Location_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Location_Service", []interface{}{})
+	fake.getSoftLayer_Location_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_ServiceCallCount() int {
+	fake.getSoftLayer_Location_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Location_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Location_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_ServiceCalls(stub func() (softlayer.SoftLayer_Location_Service, error)) {
+	fake.getSoftLayer_Location_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Location_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Location_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_ServiceReturns(result1 softlayer.SoftLayer_Location_Service, result2 error) {
+	fake.getSoftLayer_Location_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Location_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Location_ServiceStub = nil
+	fake.getSoftLayer_Location_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Location_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Location_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Location_Service, result2 error) {
+	fake.getSoftLayer_Location_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Location_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Location_ServiceStub = nil
+	if fake.getSoftLayer_Location_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Location_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Location_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Location_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Location_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Allowed_Host_Service() (softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, error) {
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturnsOnCall[len(fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceArgsForCall)]
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceArgsForCall = append(fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Network_Storage_Allowed_Host_ServiceStub
+	fakeReturns := fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Network_Storage_Allowed_Host_Service", []interface{}{})
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Allowed_Host_ServiceCallCount() int {
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Allowed_Host_ServiceCalls(stub func() (softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, error)) {
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Network_Storage_Allowed_Host_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Allowed_Host_ServiceReturns(result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, result2 error) {
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Network_Storage_Allowed_Host_ServiceStub = nil
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Allowed_Host_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service, result2 error) {
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Network_Storage_Allowed_Host_ServiceStub = nil
+	if fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Network_Storage_Allowed_Host_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Service() (softlayer.SoftLayer_Network_Storage_Service, error) {
+	fake.getSoftLayer_Network_Storage_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Network_Storage_ServiceReturnsOnCall[len(fake.getSoftLayer_Network_Storage_ServiceArgsForCall)]
+	fake.getSoftLayer_Network_Storage_ServiceArgsForCall = append(fake.getSoftLayer_Network_Storage_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Network_Storage_ServiceStub
+	fakeReturns := fake.getSoftLayer_Network_Storage_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Network_Storage_Service", []interface{}{})
+	fake.getSoftLayer_Network_Storage_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_ServiceCallCount() int {
+	fake.getSoftLayer_Network_Storage_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Network_Storage_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Network_Storage_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_ServiceCalls(stub func() (softlayer.SoftLayer_Network_Storage_Service, error)) {
+	fake.getSoftLayer_Network_Storage_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Network_Storage_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Network_Storage_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_ServiceReturns(result1 softlayer.SoftLayer_Network_Storage_Service, result2 error) {
+	fake.getSoftLayer_Network_Storage_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Network_Storage_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Network_Storage_ServiceStub = nil
+	fake.getSoftLayer_Network_Storage_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Network_Storage_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Network_Storage_Service, result2 error) {
+	fake.getSoftLayer_Network_Storage_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Network_Storage_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Network_Storage_ServiceStub = nil
+	if fake.getSoftLayer_Network_Storage_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Network_Storage_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Network_Storage_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Network_Storage_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Network_Storage_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Order_Service() (softlayer.SoftLayer_Product_Order_Service, error) {
+	fake.getSoftLayer_Product_Order_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Product_Order_ServiceReturnsOnCall[len(fake.getSoftLayer_Product_Order_ServiceArgsForCall)]
+	fake.getSoftLayer_Product_Order_ServiceArgsForCall = append(fake.getSoftLayer_Product_Order_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Product_Order_ServiceStub
+	fakeReturns := fake.getSoftLayer_Product_Order_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Product_Order_Service", []interface{}{})
+	fake.getSoftLayer_Product_Order_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Order_ServiceCallCount() int {
+	fake.getSoftLayer_Product_Order_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Product_Order_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Product_Order_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Order_ServiceCalls(stub func() (softlayer.SoftLayer_Product_Order_Service, error)) {
+	fake.getSoftLayer_Product_Order_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Product_Order_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Product_Order_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Order_ServiceReturns(result1 softlayer.SoftLayer_Product_Order_Service, result2 error) {
+	fake.getSoftLayer_Product_Order_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Product_Order_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Product_Order_ServiceStub = nil
+	fake.getSoftLayer_Product_Order_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Product_Order_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Order_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Product_Order_Service, result2 error) {
+	fake.getSoftLayer_Product_Order_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Product_Order_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Product_Order_ServiceStub = nil
+	if fake.getSoftLayer_Product_Order_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Product_Order_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Product_Order_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Product_Order_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Product_Order_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Package_Service() (softlayer.SoftLayer_Product_Package_Service, error) {
+	fake.getSoftLayer_Product_Package_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Product_Package_ServiceReturnsOnCall[len(fake.getSoftLayer_Product_Package_ServiceArgsForCall)]
+	fake.getSoftLayer_Product_Package_ServiceArgsForCall = append(fake.getSoftLayer_Product_Package_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Product_Package_ServiceStub
+	fakeReturns := fake.getSoftLayer_Product_Package_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Product_Package_Service", []interface{}{})
+	fake.getSoftLayer_Product_Package_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Package_ServiceCallCount() int {
+	fake.getSoftLayer_Product_Package_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Product_Package_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Product_Package_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Package_ServiceCalls(stub func() (softlayer.SoftLayer_Product_Package_Service, error)) {
+	fake.getSoftLayer_Product_Package_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Product_Package_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Product_Package_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Package_ServiceReturns(result1 softlayer.SoftLayer_Product_Package_Service, result2 error) {
+	fake.getSoftLayer_Product_Package_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Product_Package_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Product_Package_ServiceStub = nil
+	fake.getSoftLayer_Product_Package_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Product_Package_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Package_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Product_Package_Service, result2 error) {
+	fake.getSoftLayer_Product_Package_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Product_Package_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Product_Package_ServiceStub = nil
+	if fake.getSoftLayer_Product_Package_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Product_Package_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Product_Package_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Product_Package_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Product_Package_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Security_Ssh_Key_Service() (softlayer.SoftLayer_Security_Ssh_Key_Service, error) {
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Security_Ssh_Key_ServiceReturnsOnCall[len(fake.getSoftLayer_Security_Ssh_Key_ServiceArgsForCall)]
+	fake.getSoftLayer_Security_Ssh_Key_ServiceArgsForCall = append(fake.getSoftLayer_Security_Ssh_Key_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Security_Ssh_Key_ServiceStub
+	fakeReturns := fake.getSoftLayer_Security_Ssh_Key_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Security_Ssh_Key_Service", []interface{}{})
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Security_Ssh_Key_ServiceCallCount() int {
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Security_Ssh_Key_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Security_Ssh_Key_ServiceCalls(stub func() (softlayer.SoftLayer_Security_Ssh_Key_Service, error)) {
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Security_Ssh_Key_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Security_Ssh_Key_ServiceReturns(result1 softlayer.SoftLayer_Security_Ssh_Key_Service, result2 error) {
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Security_Ssh_Key_ServiceStub = nil
+	fake.getSoftLayer_Security_Ssh_Key_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Security_Ssh_Key_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Security_Ssh_Key_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Security_Ssh_Key_Service, result2 error) {
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Security_Ssh_Key_ServiceStub = nil
+	if fake.getSoftLayer_Security_Ssh_Key_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Security_Ssh_Key_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Security_Ssh_Key_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Security_Ssh_Key_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Security_Ssh_Key_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Service() (softlayer.SoftLayer_Ticket_Service, error) {
+	fake.getSoftLayer_Ticket_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Ticket_ServiceReturnsOnCall[len(fake.getSoftLayer_Ticket_ServiceArgsForCall)]
+	fake.getSoftLayer_Ticket_ServiceArgsForCall = append(fake.getSoftLayer_Ticket_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Ticket_ServiceStub
+	fakeReturns := fake.getSoftLayer_Ticket_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Ticket_Service", []interface{}{})
+	fake.getSoftLayer_Ticket_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_ServiceCallCount() int {
+	fake.getSoftLayer_Ticket_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Ticket_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_ServiceCalls(stub func() (softlayer.SoftLayer_Ticket_Service, error)) {
+	fake.getSoftLayer_Ticket_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_ServiceReturns(result1 softlayer.SoftLayer_Ticket_Service, result2 error) {
+	fake.getSoftLayer_Ticket_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_ServiceStub = nil
+	fake.getSoftLayer_Ticket_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Ticket_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Ticket_Service, result2 error) {
+	fake.getSoftLayer_Ticket_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_ServiceStub = nil
+	if fake.getSoftLayer_Ticket_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Ticket_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Ticket_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Ticket_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Ticket_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Status_Service() (softlayer.SoftLayer_Ticket_Status_Service, error) {
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Ticket_Status_ServiceReturnsOnCall[len(fake.getSoftLayer_Ticket_Status_ServiceArgsForCall)]
+	fake.getSoftLayer_Ticket_Status_ServiceArgsForCall = append(fake.getSoftLayer_Ticket_Status_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Ticket_Status_ServiceStub
+	fakeReturns := fake.getSoftLayer_Ticket_Status_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Ticket_Status_Service", []interface{}{})
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Status_ServiceCallCount() int {
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_Status_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Ticket_Status_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Status_ServiceCalls(stub func() (softlayer.SoftLayer_Ticket_Status_Service, error)) {
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_Status_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_Status_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Status_ServiceReturns(result1 softlayer.SoftLayer_Ticket_Status_Service, result2 error) {
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_Status_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_Status_ServiceStub = nil
+	fake.getSoftLayer_Ticket_Status_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Ticket_Status_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Status_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Ticket_Status_Service, result2 error) {
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_Status_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_Status_ServiceStub = nil
+	if fake.getSoftLayer_Ticket_Status_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Ticket_Status_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Ticket_Status_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Ticket_Status_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Ticket_Status_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Subject_Service() (softlayer.SoftLayer_Ticket_Subject_Service, error) {
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Ticket_Subject_ServiceReturnsOnCall[len(fake.getSoftLayer_Ticket_Subject_ServiceArgsForCall)]
+	fake.getSoftLayer_Ticket_Subject_ServiceArgsForCall = append(fake.getSoftLayer_Ticket_Subject_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Ticket_Subject_ServiceStub
+	fakeReturns := fake.getSoftLayer_Ticket_Subject_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Ticket_Subject_Service", []interface{}{})
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Subject_ServiceCallCount() int {
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_Subject_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Ticket_Subject_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Subject_ServiceCalls(stub func() (softlayer.SoftLayer_Ticket_Subject_Service, error)) {
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_Subject_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_Subject_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Subject_ServiceReturns(result1 softlayer.SoftLayer_Ticket_Subject_Service, result2 error) {
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_Subject_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_Subject_ServiceStub = nil
+	fake.getSoftLayer_Ticket_Subject_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Ticket_Subject_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Ticket_Subject_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Ticket_Subject_Service, result2 error) {
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Ticket_Subject_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Ticket_Subject_ServiceStub = nil
+	if fake.getSoftLayer_Ticket_Subject_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Ticket_Subject_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Ticket_Subject_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Ticket_Subject_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Ticket_Subject_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Disk_Image_Service() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error) {
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Virtual_Disk_Image_ServiceReturnsOnCall[len(fake.getSoftLayer_Virtual_Disk_Image_ServiceArgsForCall)]
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceArgsForCall = append(fake.getSoftLayer_Virtual_Disk_Image_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Virtual_Disk_Image_ServiceStub
+	fakeReturns := fake.getSoftLayer_Virtual_Disk_Image_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Virtual_Disk_Image_Service", []interface{}{})
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Disk_Image_ServiceCallCount() int {
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Virtual_Disk_Image_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Disk_Image_ServiceCalls(stub func() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error)) {
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Disk_Image_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Disk_Image_ServiceReturns(result1 softlayer.SoftLayer_Virtual_Disk_Image_Service, result2 error) {
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Disk_Image_ServiceStub = nil
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Virtual_Disk_Image_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Disk_Image_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Virtual_Disk_Image_Service, result2 error) {
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Disk_Image_ServiceStub = nil
+	if fake.getSoftLayer_Virtual_Disk_Image_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Virtual_Disk_Image_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Virtual_Disk_Image_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Virtual_Disk_Image_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error) {
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturnsOnCall[len(fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceArgsForCall)]
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceArgsForCall = append(fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub
+	fakeReturns := fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service", []interface{}{})
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceCallCount() int {
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceCalls(stub func() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error)) {
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturns(result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, result2 error) {
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub = nil
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, result2 error) {
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub = nil
+	if fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Service() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.Lock()
+	ret, specificReturn := fake.getSoftLayer_Virtual_Guest_ServiceReturnsOnCall[len(fake.getSoftLayer_Virtual_Guest_ServiceArgsForCall)]
+	fake.getSoftLayer_Virtual_Guest_ServiceArgsForCall = append(fake.getSoftLayer_Virtual_Guest_ServiceArgsForCall, struct {
+	}{})
+	stub := fake.GetSoftLayer_Virtual_Guest_ServiceStub
+	fakeReturns := fake.getSoftLayer_Virtual_Guest_ServiceReturns
+	fake.recordInvocation("GetSoftLayer_Virtual_Guest_Service", []interface{}{})
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_ServiceCallCount() int {
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Guest_ServiceMutex.RUnlock()
+	return len(fake.getSoftLayer_Virtual_Guest_ServiceArgsForCall)
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_ServiceCalls(stub func() (softlayer.SoftLayer_Virtual_Guest_Service, error)) {
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Guest_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Guest_ServiceStub = stub
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_ServiceReturns(result1 softlayer.SoftLayer_Virtual_Guest_Service, result2 error) {
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Guest_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Guest_ServiceStub = nil
+	fake.getSoftLayer_Virtual_Guest_ServiceReturns = struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_ServiceReturnsOnCall(i int, result1 softlayer.SoftLayer_Virtual_Guest_Service, result2 error) {
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.Lock()
+	defer fake.getSoftLayer_Virtual_Guest_ServiceMutex.Unlock()
+	fake.GetSoftLayer_Virtual_Guest_ServiceStub = nil
+	if fake.getSoftLayer_Virtual_Guest_ServiceReturnsOnCall == nil {
+		fake.getSoftLayer_Virtual_Guest_ServiceReturnsOnCall = make(map[int]struct {
+			result1 softlayer.SoftLayer_Virtual_Guest_Service
+			result2 error
+		})
+	}
+	fake.getSoftLayer_Virtual_Guest_ServiceReturnsOnCall[i] = struct {
+		result1 softlayer.SoftLayer_Virtual_Guest_Service
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getHttpClientMutex.RLock()
+	defer fake.getHttpClientMutex.RUnlock()
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	fake.getSoftLayer_Account_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Account_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Billing_Item_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Item_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Billing_Order_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Order_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Dns_Domain_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Hardware_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Hardware_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Location_Datacenter_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Location_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Location_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Network_Storage_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Network_Storage_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Product_Order_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Product_Order_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Product_Package_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Product_Package_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Ticket_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_Status_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_Subject_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.RUnlock()
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Guest_ServiceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ softlayer.Client = new(FakeClient)
@@ -0,0 +1,205 @@
+package softlayer_fakes
+
+//go:generate go run generate.go
+
+// NewFakeClient returns a FakeClient whose GetSoftLayer_*_Service and GetHttpClient methods return fakes, e.g.
+//
+//	client := softlayer_fakes.NewFakeClient()
+//	client.VirtualGuestService().GetPowerStateReturns(datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "RUNNING"}, nil)
+func NewFakeClient() *FakeClient {
+	client := &FakeClient{}
+
+	client.GetSoftLayer_Account_ServiceReturns(&FakeSoftLayer_Account_Service{}, nil)
+	client.GetSoftLayer_Virtual_Guest_ServiceReturns(&FakeSoftLayer_Virtual_Guest_Service{}, nil)
+	client.GetSoftLayer_Virtual_Disk_Image_ServiceReturns(&FakeSoftLayer_Virtual_Disk_Image_Service{}, nil)
+	client.GetSoftLayer_Security_Ssh_Key_ServiceReturns(&FakeSoftLayer_Security_Ssh_Key_Service{}, nil)
+	client.GetSoftLayer_Product_Order_ServiceReturns(&FakeSoftLayer_Product_Order_Service{}, nil)
+	client.GetSoftLayer_Product_Package_ServiceReturns(&FakeSoftLayer_Product_Package_Service{}, nil)
+	client.GetSoftLayer_Network_Storage_ServiceReturns(&FakeSoftLayer_Network_Storage_Service{}, nil)
+	client.GetSoftLayer_Network_Storage_Allowed_Host_ServiceReturns(&FakeSoftLayer_Network_Storage_Allowed_Host_Service{}, nil)
+	client.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceReturns(&FakeSoftLayer_Billing_Item_Cancellation_Request_Service{}, nil)
+	client.GetSoftLayer_Billing_Item_ServiceReturns(&FakeSoftLayer_Billing_Item_Service{}, nil)
+	client.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturns(&FakeSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service{}, nil)
+	client.GetSoftLayer_Hardware_ServiceReturns(&FakeSoftLayer_Hardware_Service{}, nil)
+	client.GetSoftLayer_Dns_Domain_ServiceReturns(&FakeSoftLayer_Dns_Domain_Service{}, nil)
+	client.GetSoftLayer_Dns_Domain_ResourceRecord_ServiceReturns(&FakeSoftLayer_Dns_Domain_ResourceRecord_Service{}, nil)
+	client.GetSoftLayer_Billing_Order_ServiceReturns(&FakeSoftLayer_Billing_Order_Service{}, nil)
+	client.GetSoftLayer_Location_ServiceReturns(&FakeSoftLayer_Location_Service{}, nil)
+	client.GetSoftLayer_Location_Datacenter_ServiceReturns(&FakeSoftLayer_Location_Datacenter_Service{}, nil)
+	client.GetSoftLayer_Ticket_ServiceReturns(&FakeSoftLayer_Ticket_Service{}, nil)
+	client.GetSoftLayer_Ticket_Status_ServiceReturns(&FakeSoftLayer_Ticket_Status_Service{}, nil)
+	client.GetSoftLayer_Ticket_Subject_ServiceReturns(&FakeSoftLayer_Ticket_Subject_Service{}, nil)
+	client.GetHttpClientReturns(&FakeHttpClient{})
+
+	return client
+}
+
+// The accessors below return the fakes returned by a client built with NewFakeClient, nil once their returns are replaced
+
+func (fake *FakeClient) AccountService() *FakeSoftLayer_Account_Service {
+	fake.getSoftLayer_Account_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Account_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Account_ServiceReturns.result1.(*FakeSoftLayer_Account_Service)
+	return service
+}
+
+func (fake *FakeClient) VirtualGuestService() *FakeSoftLayer_Virtual_Guest_Service {
+	fake.getSoftLayer_Virtual_Guest_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Guest_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Virtual_Guest_ServiceReturns.result1.(*FakeSoftLayer_Virtual_Guest_Service)
+	return service
+}
+
+func (fake *FakeClient) VirtualDiskImageService() *FakeSoftLayer_Virtual_Disk_Image_Service {
+	fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Disk_Image_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Virtual_Disk_Image_ServiceReturns.result1.(*FakeSoftLayer_Virtual_Disk_Image_Service)
+	return service
+}
+
+func (fake *FakeClient) SecuritySshKeyService() *FakeSoftLayer_Security_Ssh_Key_Service {
+	fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Security_Ssh_Key_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Security_Ssh_Key_ServiceReturns.result1.(*FakeSoftLayer_Security_Ssh_Key_Service)
+	return service
+}
+
+func (fake *FakeClient) ProductOrderService() *FakeSoftLayer_Product_Order_Service {
+	fake.getSoftLayer_Product_Order_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Product_Order_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Product_Order_ServiceReturns.result1.(*FakeSoftLayer_Product_Order_Service)
+	return service
+}
+
+func (fake *FakeClient) ProductPackageService() *FakeSoftLayer_Product_Package_Service {
+	fake.getSoftLayer_Product_Package_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Product_Package_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Product_Package_ServiceReturns.result1.(*FakeSoftLayer_Product_Package_Service)
+	return service
+}
+
+func (fake *FakeClient) NetworkStorageService() *FakeSoftLayer_Network_Storage_Service {
+	fake.getSoftLayer_Network_Storage_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Network_Storage_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Network_Storage_ServiceReturns.result1.(*FakeSoftLayer_Network_Storage_Service)
+	return service
+}
+
+func (fake *FakeClient) NetworkStorageAllowedHostService() *FakeSoftLayer_Network_Storage_Allowed_Host_Service {
+	fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Network_Storage_Allowed_Host_ServiceReturns.result1.(*FakeSoftLayer_Network_Storage_Allowed_Host_Service)
+	return service
+}
+
+func (fake *FakeClient) BillingItemCancellationRequestService() *FakeSoftLayer_Billing_Item_Cancellation_Request_Service {
+	fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Billing_Item_Cancellation_Request_ServiceReturns.result1.(*FakeSoftLayer_Billing_Item_Cancellation_Request_Service)
+	return service
+}
+
+func (fake *FakeClient) BillingItemService() *FakeSoftLayer_Billing_Item_Service {
+	fake.getSoftLayer_Billing_Item_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Item_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Billing_Item_ServiceReturns.result1.(*FakeSoftLayer_Billing_Item_Service)
+	return service
+}
+
+func (fake *FakeClient) VirtualGuestBlockDeviceTemplateGroupService() *FakeSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service {
+	fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceReturns.result1.(*FakeSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service)
+	return service
+}
+
+func (fake *FakeClient) HardwareService() *FakeSoftLayer_Hardware_Service {
+	fake.getSoftLayer_Hardware_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Hardware_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Hardware_ServiceReturns.result1.(*FakeSoftLayer_Hardware_Service)
+	return service
+}
+
+func (fake *FakeClient) DnsDomainService() *FakeSoftLayer_Dns_Domain_Service {
+	fake.getSoftLayer_Dns_Domain_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Dns_Domain_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Dns_Domain_ServiceReturns.result1.(*FakeSoftLayer_Dns_Domain_Service)
+	return service
+}
+
+func (fake *FakeClient) DnsDomainResourceRecordService() *FakeSoftLayer_Dns_Domain_ResourceRecord_Service {
+	fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Dns_Domain_ResourceRecord_ServiceReturns.result1.(*FakeSoftLayer_Dns_Domain_ResourceRecord_Service)
+	return service
+}
+
+func (fake *FakeClient) BillingOrderService() *FakeSoftLayer_Billing_Order_Service {
+	fake.getSoftLayer_Billing_Order_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Billing_Order_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Billing_Order_ServiceReturns.result1.(*FakeSoftLayer_Billing_Order_Service)
+	return service
+}
+
+func (fake *FakeClient) LocationService() *FakeSoftLayer_Location_Service {
+	fake.getSoftLayer_Location_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Location_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Location_ServiceReturns.result1.(*FakeSoftLayer_Location_Service)
+	return service
+}
+
+func (fake *FakeClient) LocationDatacenterService() *FakeSoftLayer_Location_Datacenter_Service {
+	fake.getSoftLayer_Location_Datacenter_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Location_Datacenter_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Location_Datacenter_ServiceReturns.result1.(*FakeSoftLayer_Location_Datacenter_Service)
+	return service
+}
+
+func (fake *FakeClient) TicketService() *FakeSoftLayer_Ticket_Service {
+	fake.getSoftLayer_Ticket_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Ticket_ServiceReturns.result1.(*FakeSoftLayer_Ticket_Service)
+	return service
+}
+
+func (fake *FakeClient) TicketStatusService() *FakeSoftLayer_Ticket_Status_Service {
+	fake.getSoftLayer_Ticket_Status_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_Status_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Ticket_Status_ServiceReturns.result1.(*FakeSoftLayer_Ticket_Status_Service)
+	return service
+}
+
+func (fake *FakeClient) TicketSubjectService() *FakeSoftLayer_Ticket_Subject_Service {
+	fake.getSoftLayer_Ticket_Subject_ServiceMutex.RLock()
+	defer fake.getSoftLayer_Ticket_Subject_ServiceMutex.RUnlock()
+
+	service, _ := fake.getSoftLayer_Ticket_Subject_ServiceReturns.result1.(*FakeSoftLayer_Ticket_Subject_Service)
+	return service
+}
+
+func (fake *FakeClient) HttpClient() *FakeHttpClient {
+	fake.getHttpClientMutex.RLock()
+	defer fake.getHttpClientMutex.RUnlock()
+
+	httpClient, _ := fake.getHttpClientReturns.result1.(*FakeHttpClient)
+	return httpClient
+}
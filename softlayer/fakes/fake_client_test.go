@@ -0,0 +1,67 @@
+package softlayer_fakes_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	softlayer_fakes "github.com/maximilien/softlayer-go/softlayer/fakes"
+)
+
+var _ = Describe("FakeClient", func() {
+	var (
+		fakeClient *softlayer_fakes.FakeClient
+		client     softlayer.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = softlayer_fakes.NewFakeClient()
+		client = fakeClient
+	})
+
+	Context("#GetSoftLayer_Virtual_Guest_Service", func() {
+		It("returns the fake of the service", func() {
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuestService).To(BeIdenticalTo(fakeClient.VirtualGuestService()))
+			Expect(fakeClient.GetSoftLayer_Virtual_Guest_ServiceCallCount()).To(Equal(1))
+		})
+
+		It("answers the calls with the stubbed returns and records their arguments", func() {
+			fakeClient.VirtualGuestService().GetPowerStateReturns(datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "RUNNING"}, nil)
+			fakeClient.VirtualGuestService().GetPowerStateReturnsOnCall(1, datatypes.SoftLayer_Virtual_Guest_Power_State{}, errors.New("fake-error"))
+
+			virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			powerState, err := virtualGuestService.GetPowerState(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState.KeyName).To(Equal("RUNNING"))
+
+			_, err = virtualGuestService.GetPowerState(5678)
+			Expect(err).To(MatchError("fake-error"))
+
+			Expect(fakeClient.VirtualGuestService().GetPowerStateCallCount()).To(Equal(2))
+			Expect(fakeClient.VirtualGuestService().GetPowerStateArgsForCall(1)).To(Equal(5678))
+		})
+	})
+
+	Context("#GetHttpClient", func() {
+		It("returns the fake HTTP client", func() {
+			Expect(client.GetHttpClient()).To(BeIdenticalTo(fakeClient.HttpClient()))
+		})
+	})
+
+	Context("when the returns of a service are replaced", func() {
+		It("returns the new returns", func() {
+			fakeClient.GetSoftLayer_Account_ServiceReturns(nil, errors.New("fake-error"))
+
+			_, err := client.GetSoftLayer_Account_Service()
+			Expect(err).To(MatchError("fake-error"))
+			Expect(fakeClient.AccountService()).To(BeNil())
+		})
+	})
+})
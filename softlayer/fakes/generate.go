@@ -0,0 +1,328 @@
+//go:build ignore
+
+// generate writes a counterfeiter-style fake of each interface of the softlayer package, run it with go generate
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	SOFTLAYER_PACKAGE_PATH = "github.com/maximilien/softlayer-go/softlayer"
+	FAKES_PACKAGE_NAME     = "softlayer_fakes"
+)
+
+var IMPORT_NAMES = map[string]string{
+	SOFTLAYER_PACKAGE_PATH:                          "softlayer",
+	"github.com/maximilien/softlayer-go/data_types": "datatypes",
+}
+
+func main() {
+	fset := token.NewFileSet()
+	softlayerPackage, err := importer.ForCompiler(fset, "source", nil).Import(SOFTLAYER_PACKAGE_PATH)
+	if err != nil {
+		log.Fatalf("Cannot load %s: %s", SOFTLAYER_PACKAGE_PATH, err.Error())
+	}
+
+	scope := softlayerPackage.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !typeName.Exported() {
+			continue
+		}
+
+		iface, ok := typeName.Type().Underlying().(*types.Interface)
+		if !ok {
+			continue
+		}
+
+		fileName := fakeFileName(name)
+		source, err := generateFake(name, iface)
+		if err != nil {
+			log.Fatalf("Cannot generate the fake of %s: %s", name, err.Error())
+		}
+
+		if err := ioutil.WriteFile(fileName, source, 0644); err != nil {
+			log.Fatalf("Cannot write %s: %s", fileName, err.Error())
+		}
+	}
+}
+
+// Private types
+
+type method struct {
+	name    string
+	private string
+
+	//A variadic parameter is recorded as a slice
+	params   []string
+	results  []string
+	variadic bool
+
+	signature string
+}
+
+// Private functions
+
+func generateFake(interfaceName string, iface *types.Interface) ([]byte, error) {
+	imports := map[string]string{"sync": "sync", SOFTLAYER_PACKAGE_PATH: "softlayer"}
+	qualifier := func(p *types.Package) string {
+		name, ok := IMPORT_NAMES[p.Path()]
+		if !ok {
+			name = p.Name()
+		}
+
+		imports[p.Path()] = name
+		return name
+	}
+
+	methods := []method{}
+	for i := 0; i < iface.NumMethods(); i++ {
+		methods = append(methods, newMethod(iface.Method(i), qualifier))
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	fakeName := "Fake" + interfaceName
+	out := &bytes.Buffer{}
+
+	fmt.Fprintf(out, "// Code generated by softlayer/fakes/generate.go. DO NOT EDIT.\n\npackage %s\n\nimport (\n", FAKES_PACKAGE_NAME)
+	paths := []string{}
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if !strings.Contains(path, ".") {
+			fmt.Fprintf(out, "\t%q\n", path)
+		}
+	}
+	fmt.Fprintf(out, "\n")
+	for _, path := range paths {
+		if strings.Contains(path, ".") {
+			fmt.Fprintf(out, "\t%s %q\n", imports[path], path)
+		}
+	}
+	fmt.Fprintf(out, ")\n\n")
+
+	fmt.Fprintf(out, "type %s struct {\n", fakeName)
+	for _, m := range methods {
+		fmt.Fprintf(out, "\t%sStub func%s\n", m.name, m.signature)
+		fmt.Fprintf(out, "\t%sMutex sync.RWMutex\n", m.private)
+		fmt.Fprintf(out, "\t%sArgsForCall []struct {\n%s\t}\n", m.private, fields("arg", m.params))
+		if len(m.results) > 0 {
+			fmt.Fprintf(out, "\t%sReturns struct {\n%s\t}\n", m.private, fields("result", m.results))
+			fmt.Fprintf(out, "\t%sReturnsOnCall map[int]struct {\n%s\t}\n", m.private, fields("result", m.results))
+		}
+	}
+	fmt.Fprintf(out, "\tinvocations map[string][][]interface{}\n\tinvocationsMutex sync.RWMutex\n}\n\n")
+
+	for _, m := range methods {
+		writeMethod(out, fakeName, m)
+	}
+
+	fmt.Fprintf(out, "func (fake *%s) Invocations() map[string][][]interface{} {\n", fakeName)
+	fmt.Fprintf(out, "\tfake.invocationsMutex.RLock()\n\tdefer fake.invocationsMutex.RUnlock()\n")
+	for _, m := range methods {
+		fmt.Fprintf(out, "\tfake.%sMutex.RLock()\n\tdefer fake.%sMutex.RUnlock()\n", m.private, m.private)
+	}
+	fmt.Fprintf(out, "\tcopiedInvocations := map[string][][]interface{}{}\n\tfor key, value := range fake.invocations {\n\t\tcopiedInvocations[key] = value\n\t}\n\treturn copiedInvocations\n}\n\n")
+
+	fmt.Fprintf(out, "func (fake *%s) recordInvocation(key string, args []interface{}) {\n", fakeName)
+	fmt.Fprintf(out, "\tfake.invocationsMutex.Lock()\n\tdefer fake.invocationsMutex.Unlock()\n")
+	fmt.Fprintf(out, "\tif fake.invocations == nil {\n\t\tfake.invocations = map[string][][]interface{}{}\n\t}\n")
+	fmt.Fprintf(out, "\tif fake.invocations[key] == nil {\n\t\tfake.invocations[key] = [][]interface{}{}\n\t}\n")
+	fmt.Fprintf(out, "\tfake.invocations[key] = append(fake.invocations[key], args)\n}\n\n")
+
+	fmt.Fprintf(out, "var _ softlayer.%s = new(%s)\n", interfaceName, fakeName)
+
+	return format.Source(out.Bytes())
+}
+
+func newMethod(function *types.Func, qualifier types.Qualifier) method {
+	signature := function.Type().(*types.Signature)
+
+	m := method{
+		name:     function.Name(),
+		private:  lowerFirst(function.Name()),
+		variadic: signature.Variadic(),
+	}
+
+	for i := 0; i < signature.Params().Len(); i++ {
+		m.params = append(m.params, types.TypeString(signature.Params().At(i).Type(), qualifier))
+	}
+
+	for i := 0; i < signature.Results().Len(); i++ {
+		m.results = append(m.results, types.TypeString(signature.Results().At(i).Type(), qualifier))
+	}
+
+	m.signature = "(" + strings.Join(paramTypes(m), ", ") + ")"
+	if len(m.results) == 1 {
+		m.signature += " " + m.results[0]
+	} else if len(m.results) > 1 {
+		m.signature += " (" + strings.Join(m.results, ", ") + ")"
+	}
+
+	return m
+}
+
+func writeMethod(out *bytes.Buffer, fakeName string, m method) {
+	args := names("arg", len(m.params))
+	results := names("result", len(m.results))
+
+	callArgs := strings.Join(args, ", ")
+	if m.variadic {
+		callArgs += "..."
+	}
+
+	declaredParams := []string{}
+	for i, paramType := range paramTypes(m) {
+		declaredParams = append(declaredParams, args[i]+" "+paramType)
+	}
+
+	resultList := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		resultList = "(" + resultList + ")"
+	}
+
+	//The method
+	fmt.Fprintf(out, "func (fake *%s) %s(%s) %s {\n", fakeName, m.name, strings.Join(declaredParams, ", "), resultList)
+
+	recordedArgs := []string{}
+	for i, arg := range args {
+		if strings.HasPrefix(m.params[i], "[]") {
+			fmt.Fprintf(out, "\tvar %sCopy %s\n\tif %s != nil {\n\t\t%sCopy = make(%s, len(%s))\n\t\tcopy(%sCopy, %s)\n\t}\n", arg, m.params[i], arg, arg, m.params[i], arg, arg, arg)
+			recordedArgs = append(recordedArgs, arg+"Copy")
+		} else {
+			recordedArgs = append(recordedArgs, arg)
+		}
+	}
+
+	fmt.Fprintf(out, "\tfake.%sMutex.Lock()\n", m.private)
+	if len(m.results) > 0 {
+		fmt.Fprintf(out, "\tret, specificReturn := fake.%sReturnsOnCall[len(fake.%sArgsForCall)]\n", m.private, m.private)
+	}
+	fmt.Fprintf(out, "\tfake.%sArgsForCall = append(fake.%sArgsForCall, struct {\n%s\t}{%s})\n", m.private, m.private, fields("arg", m.params), strings.Join(recordedArgs, ", "))
+	fmt.Fprintf(out, "\tstub := fake.%sStub\n", m.name)
+	if len(m.results) > 0 {
+		fmt.Fprintf(out, "\tfakeReturns := fake.%sReturns\n", m.private)
+	}
+	fmt.Fprintf(out, "\tfake.recordInvocation(%q, []interface{}{%s})\n", m.name, strings.Join(recordedArgs, ", "))
+	fmt.Fprintf(out, "\tfake.%sMutex.Unlock()\n", m.private)
+
+	if len(m.results) == 0 {
+		fmt.Fprintf(out, "\tif stub != nil {\n\t\tstub(%s)\n\t}\n}\n\n", callArgs)
+	} else {
+		fmt.Fprintf(out, "\tif stub != nil {\n\t\treturn stub(%s)\n\t}\n", callArgs)
+		fmt.Fprintf(out, "\tif specificReturn {\n\t\treturn %s\n\t}\n", prefixed("ret.", results))
+		fmt.Fprintf(out, "\treturn %s\n}\n\n", prefixed("fakeReturns.", results))
+	}
+
+	//CallCount
+	fmt.Fprintf(out, "func (fake *%s) %sCallCount() int {\n", fakeName, m.name)
+	fmt.Fprintf(out, "\tfake.%sMutex.RLock()\n\tdefer fake.%sMutex.RUnlock()\n", m.private, m.private)
+	fmt.Fprintf(out, "\treturn len(fake.%sArgsForCall)\n}\n\n", m.private)
+
+	//Calls
+	fmt.Fprintf(out, "func (fake *%s) %sCalls(stub func%s) {\n", fakeName, m.name, m.signature)
+	fmt.Fprintf(out, "\tfake.%sMutex.Lock()\n\tdefer fake.%sMutex.Unlock()\n", m.private, m.private)
+	fmt.Fprintf(out, "\tfake.%sStub = stub\n}\n\n", m.name)
+
+	//ArgsForCall
+	if len(args) > 0 {
+		argResults := strings.Join(m.params, ", ")
+		if len(args) > 1 {
+			argResults = "(" + argResults + ")"
+		}
+
+		fmt.Fprintf(out, "func (fake *%s) %sArgsForCall(i int) %s {\n", fakeName, m.name, argResults)
+		fmt.Fprintf(out, "\tfake.%sMutex.RLock()\n\tdefer fake.%sMutex.RUnlock()\n", m.private, m.private)
+		fmt.Fprintf(out, "\targsForCall := fake.%sArgsForCall[i]\n", m.private)
+		fmt.Fprintf(out, "\treturn %s\n}\n\n", prefixed("argsForCall.", args))
+	}
+
+	if len(m.results) == 0 {
+		return
+	}
+
+	declaredResults := []string{}
+	for i, resultType := range m.results {
+		declaredResults = append(declaredResults, results[i]+" "+resultType)
+	}
+
+	//Returns
+	fmt.Fprintf(out, "func (fake *%s) %sReturns(%s) {\n", fakeName, m.name, strings.Join(declaredResults, ", "))
+	fmt.Fprintf(out, "\tfake.%sMutex.Lock()\n\tdefer fake.%sMutex.Unlock()\n", m.private, m.private)
+	fmt.Fprintf(out, "\tfake.%sStub = nil\n", m.name)
+	fmt.Fprintf(out, "\tfake.%sReturns = struct {\n%s\t}{%s}\n}\n\n", m.private, fields("result", m.results), strings.Join(results, ", "))
+
+	//ReturnsOnCall
+	fmt.Fprintf(out, "func (fake *%s) %sReturnsOnCall(i int, %s) {\n", fakeName, m.name, strings.Join(declaredResults, ", "))
+	fmt.Fprintf(out, "\tfake.%sMutex.Lock()\n\tdefer fake.%sMutex.Unlock()\n", m.private, m.private)
+	fmt.Fprintf(out, "\tfake.%sStub = nil\n", m.name)
+	fmt.Fprintf(out, "\tif fake.%sReturnsOnCall == nil {\n\t\tfake.%sReturnsOnCall = make(map[int]struct {\n%s\t\t})\n\t}\n", m.private, m.private, fields("result", m.results))
+	fmt.Fprintf(out, "\tfake.%sReturnsOnCall[i] = struct {\n%s\t}{%s}\n}\n\n", m.private, fields("result", m.results), strings.Join(results, ", "))
+}
+
+// paramTypes are the types of the parameters in a signature, e.g. ...string for a variadic []string
+func paramTypes(m method) []string {
+	declared := append([]string{}, m.params...)
+	if m.variadic && len(declared) > 0 {
+		declared[len(declared)-1] = "..." + strings.TrimPrefix(declared[len(declared)-1], "[]")
+	}
+
+	return declared
+}
+
+func fields(prefix string, fieldTypes []string) string {
+	lines := ""
+	for i, fieldType := range fieldTypes {
+		lines += fmt.Sprintf("\t\t%s%d %s\n", prefix, i+1, fieldType)
+	}
+
+	return lines
+}
+
+func names(prefix string, count int) []string {
+	result := []string{}
+	for i := 1; i <= count; i++ {
+		result = append(result, fmt.Sprintf("%s%d", prefix, i))
+	}
+
+	return result
+}
+
+func prefixed(prefix string, values []string) string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, prefix+value)
+	}
+
+	return strings.Join(result, ", ")
+}
+
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// fakeFileName is the snake case name of the interface, e.g. softlayer_virtual_guest_service_fake.go or http_client_fake.go
+func fakeFileName(interfaceName string) string {
+	snake := strings.Replace(interfaceName, "SoftLayer", "Softlayer", -1)
+	snake = regexp.MustCompile(`([a-z0-9])([A-Z])`).ReplaceAllString(snake, "${1}_${2}")
+	snake = strings.Replace(strings.ToLower(snake), "__", "_", -1)
+
+	return snake + "_fake.go"
+}
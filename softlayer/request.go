@@ -0,0 +1,153 @@
+package softlayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DEFAULT_RESULT_LIMIT is the page size of the calls given an offset without result limit
+const DEFAULT_RESULT_LIMIT = 100
+
+// Request describes a single call to the SoftLayer REST API, e.g.
+//
+//	softlayer.NewRequest("SoftLayer_Virtual_Guest", "getObject").WithId(1234).WithMask("id", "hostname")
+type Request struct {
+	Service string
+	Method  string
+	Id      int
+
+	Parameters []interface{}
+
+	Mask   []string
+	Filter string
+
+	ResultLimit int
+	Offset      int
+
+	HttpMethod string
+}
+
+func NewRequest(service string, method string) *Request {
+	return &Request{
+		Service: service,
+		Method:  method,
+	}
+}
+
+func (r *Request) WithId(id int) *Request {
+	r.Id = id
+	return r
+}
+
+func (r *Request) WithParameters(parameters ...interface{}) *Request {
+	r.Parameters = parameters
+	return r
+}
+
+func (r *Request) WithMask(mask ...string) *Request {
+	r.Mask = append(r.Mask, mask...)
+	return r
+}
+
+func (r *Request) WithFilter(filter string) *Request {
+	r.Filter = filter
+	return r
+}
+
+func (r *Request) WithResultLimit(resultLimit int) *Request {
+	r.ResultLimit = resultLimit
+	return r
+}
+
+// WithOffset skips the first results of a list method; without WithResultLimit, DEFAULT_RESULT_LIMIT results are returned
+func (r *Request) WithOffset(offset int) *Request {
+	r.Offset = offset
+	return r
+}
+
+func (r *Request) WithHttpMethod(httpMethod string) *Request {
+	r.HttpMethod = httpMethod
+	return r
+}
+
+// Path returns the REST path of the call, relative to the API endpoint, e.g. SoftLayer_Virtual_Guest/1234/getObject.json
+func (r *Request) Path() string {
+	path := r.Service
+	if r.Id != 0 {
+		path += fmt.Sprintf("/%d", r.Id)
+	}
+
+	if r.Method != "" {
+		path += "/" + r.Method
+	}
+
+	return path + ".json"
+}
+
+func (r *Request) Query() url.Values {
+	query := url.Values{}
+
+	if len(r.Mask) > 0 {
+		query.Set("objectMask", r.ObjectMask())
+	}
+
+	if r.Filter != "" {
+		query.Set("objectFilter", r.Filter)
+	}
+
+	if limit := r.Limit(); limit > 0 {
+		query.Set("resultLimit", fmt.Sprintf("%d,%d", r.Offset, limit))
+	}
+
+	return query
+}
+
+// Limit returns the number of results to fetch: ResultLimit when set, DEFAULT_RESULT_LIMIT for an Offset alone, otherwise 0 (all results)
+func (r *Request) Limit() int {
+	if r.ResultLimit <= 0 && r.Offset > 0 {
+		return DEFAULT_RESULT_LIMIT
+	}
+
+	return r.ResultLimit
+}
+
+func (r *Request) ObjectMask() string {
+	if len(r.Mask) == 0 {
+		return ""
+	}
+
+	if len(r.Mask) == 1 && strings.HasPrefix(r.Mask[0], "mask") {
+		return r.Mask[0]
+	}
+
+	return "mask[" + strings.Join(r.Mask, ";") + "]"
+}
+
+// Verb returns the HTTP method of the call: HttpMethod when set, otherwise POST for calls with parameters and GET for the others
+func (r *Request) Verb() string {
+	if r.HttpMethod != "" {
+		return r.HttpMethod
+	}
+
+	if len(r.Parameters) > 0 {
+		return "POST"
+	}
+
+	return "GET"
+}
+
+func (r *Request) Body() (*bytes.Buffer, error) {
+	if len(r.Parameters) == 0 {
+		return new(bytes.Buffer), nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"parameters": r.Parameters})
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewBuffer(body), nil
+}
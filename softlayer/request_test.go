@@ -0,0 +1,101 @@
+package softlayer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Request", func() {
+	var request *softlayer.Request
+
+	BeforeEach(func() {
+		request = softlayer.NewRequest("SoftLayer_Virtual_Guest", "getObject")
+	})
+
+	Context("#Path", func() {
+		It("builds the path from the service and method", func() {
+			Expect(request.Path()).To(Equal("SoftLayer_Virtual_Guest/getObject.json"))
+		})
+
+		It("includes the id when set", func() {
+			Expect(request.WithId(1234).Path()).To(Equal("SoftLayer_Virtual_Guest/1234/getObject.json"))
+		})
+
+		It("omits the method when empty", func() {
+			request = softlayer.NewRequest("SoftLayer_Virtual_Guest", "").WithId(1234)
+			Expect(request.Path()).To(Equal("SoftLayer_Virtual_Guest/1234.json"))
+		})
+	})
+
+	Context("#Query", func() {
+		It("is empty by default", func() {
+			Expect(request.Query().Encode()).To(Equal(""))
+		})
+
+		It("joins masks into an object mask", func() {
+			request.WithMask("id", "hostname").WithMask("operatingSystem.passwords")
+			Expect(request.Query().Get("objectMask")).To(Equal("mask[id;hostname;operatingSystem.passwords]"))
+		})
+
+		It("keeps a complete mask as is", func() {
+			request.WithMask("mask[id;datacenter[name]]")
+			Expect(request.Query().Get("objectMask")).To(Equal("mask[id;datacenter[name]]"))
+		})
+
+		It("adds the object filter", func() {
+			request.WithFilter(`{"id":{"operation":1234}}`)
+			Expect(request.Query().Get("objectFilter")).To(Equal(`{"id":{"operation":1234}}`))
+		})
+
+		It("adds the result limit with its offset", func() {
+			request.WithResultLimit(50).WithOffset(100)
+			Expect(request.Query().Get("resultLimit")).To(Equal("100,50"))
+		})
+
+		It("adds the offset with the default result limit when no result limit is given", func() {
+			request.WithOffset(50)
+			Expect(request.Limit()).To(Equal(softlayer.DEFAULT_RESULT_LIMIT))
+			Expect(request.Query().Get("resultLimit")).To(Equal("50,100"))
+		})
+
+		It("does not limit the results without result limit nor offset", func() {
+			Expect(request.Limit()).To(Equal(0))
+			Expect(request.Query()).ToNot(HaveKey("resultLimit"))
+		})
+
+		It("URL-encodes masks and filters", func() {
+			request.WithMask("id").WithFilter(`{"hostname":{"operation":"foo bar&"}}`)
+			Expect(request.Query().Encode()).To(Equal("objectFilter=%7B%22hostname%22%3A%7B%22operation%22%3A%22foo+bar%26%22%7D%7D&objectMask=mask%5Bid%5D"))
+		})
+	})
+
+	Context("#Verb", func() {
+		It("uses GET for calls without parameters", func() {
+			Expect(request.Verb()).To(Equal("GET"))
+		})
+
+		It("uses POST for calls with parameters", func() {
+			Expect(request.WithParameters("tag1,tag2").Verb()).To(Equal("POST"))
+		})
+
+		It("uses the HTTP method when set", func() {
+			Expect(request.WithHttpMethod("DELETE").Verb()).To(Equal("DELETE"))
+		})
+	})
+
+	Context("#Body", func() {
+		It("is empty for calls without parameters", func() {
+			body, err := request.Body()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.Len()).To(Equal(0))
+		})
+
+		It("wraps the parameters", func() {
+			body, err := request.WithParameters("tag1,tag2", 5).Body()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.String()).To(Equal(`{"parameters":["tag1,tag2",5]}`))
+		})
+	})
+})
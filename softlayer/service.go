@@ -1,5 +1,33 @@
 package softlayer
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
 type Service interface {
 	GetName() string
 }
+
+// ServiceFactory builds the implementation of a service for a client, e.g. services.NewSoftLayer_Virtual_Guest_Service
+type ServiceFactory func(client Client) Service
+
+// GetService returns the service of the client with the type of its interface, e.g.
+//
+//	ticketService, err := softlayer.GetService[softlayer.SoftLayer_Ticket_Service](client, "SoftLayer_Ticket")
+func GetService[T Service](client Client, name string) (T, error) {
+	var typedService T
+
+	service, err := client.GetService(name)
+	if err != nil {
+		return typedService, err
+	}
+
+	typedService, ok := service.(T)
+	if !ok {
+		return typedService, errors.New(fmt.Sprintf("softlayer-go service '%s' is a %T, not a %s", name, service, reflect.TypeOf((*T)(nil)).Elem()))
+	}
+
+	return typedService, nil
+}
@@ -0,0 +1,45 @@
+package softlayer_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	softlayer_fakes "github.com/maximilien/softlayer-go/softlayer/fakes"
+)
+
+var _ = Describe("Service", func() {
+	var fakeClient *softlayer_fakes.FakeClient
+
+	BeforeEach(func() {
+		fakeClient = softlayer_fakes.NewFakeClient()
+	})
+
+	Context(".GetService", func() {
+		It("returns the service with the type of its interface", func() {
+			fakeTicketService := &softlayer_fakes.FakeSoftLayer_Ticket_Service{}
+			fakeClient.GetServiceReturns(fakeTicketService, nil)
+
+			ticketService, err := softlayer.GetService[softlayer.SoftLayer_Ticket_Service](fakeClient, "SoftLayer_Ticket")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ticketService).To(BeIdenticalTo(fakeTicketService))
+			Expect(fakeClient.GetServiceArgsForCall(0)).To(Equal("SoftLayer_Ticket"))
+		})
+
+		It("fails when the service has another type", func() {
+			fakeClient.GetServiceReturns(&softlayer_fakes.FakeSoftLayer_Account_Service{}, nil)
+
+			_, err := softlayer.GetService[softlayer.SoftLayer_Ticket_Service](fakeClient, "SoftLayer_Ticket")
+			Expect(err).To(MatchError("softlayer-go service 'SoftLayer_Ticket' is a *softlayer_fakes.FakeSoftLayer_Account_Service, not a softlayer.SoftLayer_Ticket_Service"))
+		})
+
+		It("fails when the client does not support the service", func() {
+			fakeClient.GetServiceReturns(nil, errors.New("softlayer-go does not support service 'SoftLayer_Ticket'"))
+
+			_, err := softlayer.GetService[softlayer.SoftLayer_Ticket_Service](fakeClient, "SoftLayer_Ticket")
+			Expect(err).To(MatchError("softlayer-go does not support service 'SoftLayer_Ticket'"))
+		})
+	})
+})
@@ -1,23 +1,92 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Account_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Account_Service
+
 	GetAccountStatus() (datatypes.SoftLayer_Account_Status, error)
+	GetAccountStatusWithContext(ctx context.Context) (datatypes.SoftLayer_Account_Status, error)
 	GetVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error)
+	GetVirtualGuestsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest, error)
 	GetVirtualGuestsByFilter(filters string) ([]datatypes.SoftLayer_Virtual_Guest, error)
+	GetVirtualGuestsByFilterWithContext(ctx context.Context, filters string) ([]datatypes.SoftLayer_Virtual_Guest, error)
 	GetNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error)
+	GetNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error)
 	GetIscsiNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error)
 	GetIscsiNetworkStorageWithFilter(filter string) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiNetworkStorageWithFilterWithContext(ctx context.Context, filter string) ([]datatypes.SoftLayer_Network_Storage, error)
 	GetVirtualDiskImages() ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetVirtualDiskImagesWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
 	GetVirtualDiskImagesWithFilter(filters string) ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetVirtualDiskImagesWithFilterWithContext(ctx context.Context, filters string) ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
 	GetSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithContext(ctx context.Context) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetBlockDeviceTemplateGroups() ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetBlockDeviceTemplateGroupsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	GetBlockDeviceTemplateGroupsWithFilter(filters string) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetBlockDeviceTemplateGroupsWithFilterWithContext(ctx context.Context, filters string) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	GetDatacentersWithSubnetAllocations() ([]datatypes.SoftLayer_Location, error)
+	GetDatacentersWithSubnetAllocationsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Location, error)
 	GetHardware() ([]datatypes.SoftLayer_Hardware, error)
+	GetHardwareWithContext(ctx context.Context) ([]datatypes.SoftLayer_Hardware, error)
+
+	VirtualGuestIterator(filters string) SoftLayer_Virtual_Guest_Iterator
+	NetworkStorageIterator(filters string) SoftLayer_Network_Storage_Iterator
+	HardwareIterator(filters string) SoftLayer_Hardware_Iterator
+	SshKeyIterator(filters string) SoftLayer_Security_Ssh_Key_Iterator
+	BlockDeviceTemplateGroupIterator(filters string) SoftLayer_Virtual_Guest_Block_Device_Template_Group_Iterator
+}
+
+// Iterators fetch the results of the account list methods one page at a time:
+//
+//	iterator := accountService.VirtualGuestIterator(filters)
+//	for iterator.Next() {
+//		virtualGuests := iterator.Page()
+//	}
+//	if err := iterator.Err(); err != nil {
+//	}
+type Iterator interface {
+	Next() bool
+	NextWithContext(ctx context.Context) bool
+
+	TotalItems() int
+	Err() error
+}
+
+type SoftLayer_Virtual_Guest_Iterator interface {
+	Iterator
+
+	Page() []datatypes.SoftLayer_Virtual_Guest
+}
+
+type SoftLayer_Network_Storage_Iterator interface {
+	Iterator
+
+	Page() []datatypes.SoftLayer_Network_Storage
+}
+
+type SoftLayer_Hardware_Iterator interface {
+	Iterator
+
+	Page() []datatypes.SoftLayer_Hardware
+}
+
+type SoftLayer_Security_Ssh_Key_Iterator interface {
+	Iterator
+
+	Page() []datatypes.SoftLayer_Security_Ssh_Key
+}
+
+type SoftLayer_Virtual_Guest_Block_Device_Template_Group_Iterator interface {
+	Iterator
+
+	Page() []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group
 }
@@ -1,11 +1,16 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Billing_Item_Cancellation_Request_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Billing_Item_Cancellation_Request_Service
+
 	CreateObject(request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+	CreateObjectWithContext(ctx context.Context, request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
 }
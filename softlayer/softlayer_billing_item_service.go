@@ -1,7 +1,14 @@
 package softlayer
 
+import (
+	"context"
+)
+
 type SoftLayer_Billing_Item_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Billing_Item_Service
+
 	CancelService(billingId int) (bool, error)
+	CancelServiceWithContext(ctx context.Context, billingId int) (bool, error)
 }
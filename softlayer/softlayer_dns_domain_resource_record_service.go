@@ -1,14 +1,22 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Dns_Domain_ResourceRecord_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Dns_Domain_ResourceRecord_Service
+
 	CreateObject(template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
 	GetObject(recordId int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
+	GetObjectWithContext(ctx context.Context, recordId int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
 	DeleteObject(recordId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, recordId int) (bool, error)
 	EditObject(recordId int, template datatypes.SoftLayer_Dns_Domain_ResourceRecord) (bool, error)
+	EditObjectWithContext(ctx context.Context, recordId int, template datatypes.SoftLayer_Dns_Domain_ResourceRecord) (bool, error)
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -9,7 +11,12 @@ import (
 type SoftLayer_Dns_Domain_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Dns_Domain_Service
+
 	CreateObject(template datatypes.SoftLayer_Dns_Domain_Template) (datatypes.SoftLayer_Dns_Domain, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Dns_Domain_Template) (datatypes.SoftLayer_Dns_Domain, error)
 	DeleteObject(dnsId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, dnsId int) (bool, error)
 	GetObject(dnsId int) (datatypes.SoftLayer_Dns_Domain, error)
+	GetObjectWithContext(ctx context.Context, dnsId int) (datatypes.SoftLayer_Dns_Domain, error)
 }
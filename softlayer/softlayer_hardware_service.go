@@ -1,32 +1,56 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Hardware_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Hardware_Service
+
 	AllowAccessToNetworkStorage(id int, storage datatypes.SoftLayer_Network_Storage) (bool, error)
+	AllowAccessToNetworkStorageWithContext(ctx context.Context, id int, storage datatypes.SoftLayer_Network_Storage) (bool, error)
 
 	CreateObject(template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error)
 
 	FindByIpAddress(ipAddress string) (datatypes.SoftLayer_Hardware, error)
+	FindByIpAddressWithContext(ctx context.Context, ipAddress string) (datatypes.SoftLayer_Hardware, error)
 
 	GetObject(id int) (datatypes.SoftLayer_Hardware, error)
+	GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Hardware, error)
+	GetActiveTransactions(id int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetActiveTransactionsWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetAllowedHost(id int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error)
+	GetAllowedHostWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error)
 	GetAttachedNetworkStorages(id int, nasType string) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetAttachedNetworkStoragesWithContext(ctx context.Context, id int, nasType string) ([]datatypes.SoftLayer_Network_Storage, error)
 	GetDatacenter(id int) (datatypes.SoftLayer_Location, error)
+	GetDatacenterWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Location, error)
+	GetServerPowerState(id int) (string, error)
+	GetServerPowerStateWithContext(ctx context.Context, id int) (string, error)
 	GetPrimaryIpAddress(id int) (string, error)
+	GetPrimaryIpAddressWithContext(ctx context.Context, id int) (string, error)
 	GetPrimaryBackendIpAddress(id int) (string, error)
+	GetPrimaryBackendIpAddressWithContext(ctx context.Context, id int) (string, error)
 
 	PowerOff(instanceId int) (bool, error)
+	PowerOffWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOffSoft(instanceId int) (bool, error)
+	PowerOffSoftWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOn(instanceId int) (bool, error)
+	PowerOnWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	RebootDefault(instanceId int) (bool, error)
+	RebootDefaultWithContext(ctx context.Context, instanceId int) (bool, error)
 	RebootSoft(instanceId int) (bool, error)
+	RebootSoftWithContext(ctx context.Context, instanceId int) (bool, error)
 	RebootHard(instanceId int) (bool, error)
+	RebootHardWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	SetTags(instanceId int, tags []string) (bool, error)
+	SetTagsWithContext(ctx context.Context, instanceId int, tags []string) (bool, error)
 }
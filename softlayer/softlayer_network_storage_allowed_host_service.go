@@ -1,11 +1,16 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Network_Storage_Allowed_Host_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Network_Storage_Allowed_Host_Service
+
 	GetCredential(allowedHostId int) (datatypes.SoftLayer_Network_Storage_Credential, error)
+	GetCredentialWithContext(ctx context.Context, allowedHostId int) (datatypes.SoftLayer_Network_Storage_Credential, error)
 }
@@ -1,19 +1,31 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Network_Storage_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Network_Storage_Service
+
 	DeleteObject(volumeId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, volumeId int) (bool, error)
 
 	CreateIscsiVolume(size int, location string) (datatypes.SoftLayer_Network_Storage, error)
+	CreateIscsiVolumeWithContext(ctx context.Context, size int, location string) (datatypes.SoftLayer_Network_Storage, error)
 	DeleteIscsiVolume(volumeId int, immediateCancellationFlag bool) error
+	DeleteIscsiVolumeWithContext(ctx context.Context, volumeId int, immediateCancellationFlag bool) error
 	GetIscsiVolume(volumeId int) (datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiVolumeWithContext(ctx context.Context, volumeId int) (datatypes.SoftLayer_Network_Storage, error)
 	GetBillingItem(volumeId int) (datatypes.SoftLayer_Billing_Item, error)
+	GetBillingItemWithContext(ctx context.Context, volumeId int) (datatypes.SoftLayer_Billing_Item, error)
 	HasAllowedVirtualGuest(volumeId int, vmId int) (bool, error)
+	HasAllowedVirtualGuestWithContext(ctx context.Context, volumeId int, vmId int) (bool, error)
 	AttachIscsiVolume(virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) (bool, error)
+	AttachIscsiVolumeWithContext(ctx context.Context, virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) (bool, error)
 	DetachIscsiVolume(virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) error
+	DetachIscsiVolumeWithContext(ctx context.Context, virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) error
 }
@@ -1,13 +1,34 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Product_Order_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Product_Order_Service
+
 	PlaceOrder(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceOrderWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 	PlaceContainerOrderNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkPerformanceStorageIscsiWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 	PlaceContainerOrderVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderVirtualGuestUpgradeWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+
+	VerifyOrder(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Price_Summary, error)
+	VerifyOrderWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Price_Summary, error)
+	VerifyContainerOrderNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Price_Summary, error)
+	VerifyContainerOrderNetworkPerformanceStorageIscsiWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Price_Summary, error)
+	VerifyContainerOrderVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Price_Summary, error)
+	VerifyContainerOrderVirtualGuestUpgradeWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Price_Summary, error)
+
+	PlaceQuote(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Quote, error)
+	PlaceQuoteWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Product_Order_Quote, error)
+	PlaceContainerQuoteNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Quote, error)
+	PlaceContainerQuoteNetworkPerformanceStorageIscsiWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Product_Order_Quote, error)
+	PlaceContainerQuoteVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Quote, error)
+	PlaceContainerQuoteVirtualGuestUpgradeWithContext(ctx context.Context, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Product_Order_Quote, error)
 }
@@ -1,17 +1,27 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Product_Package_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Product_Package_Service
+
 	GetItemPrices(packageId int) ([]datatypes.SoftLayer_Product_Item_Price, error)
+	GetItemPricesWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Product_Item_Price, error)
 	GetItemPricesBySize(packageId int, size int) ([]datatypes.SoftLayer_Product_Item_Price, error)
+	GetItemPricesBySizeWithContext(ctx context.Context, packageId int, size int) ([]datatypes.SoftLayer_Product_Item_Price, error)
 	GetItems(packageId int) ([]datatypes.SoftLayer_Product_Item, error)
+	GetItemsWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Product_Item, error)
 	GetItemsByType(packageType string) ([]datatypes.SoftLayer_Product_Item, error)
+	GetItemsByTypeWithContext(ctx context.Context, packageType string) ([]datatypes.SoftLayer_Product_Item, error)
 
 	GetPackagesByType(packageType string) ([]datatypes.Softlayer_Product_Package, error)
+	GetPackagesByTypeWithContext(ctx context.Context, packageType string) ([]datatypes.Softlayer_Product_Package, error)
 	GetOnePackageByType(packageType string) (datatypes.Softlayer_Product_Package, error)
+	GetOnePackageByTypeWithContext(ctx context.Context, packageType string) (datatypes.Softlayer_Product_Package, error)
 }
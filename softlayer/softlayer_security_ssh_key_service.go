@@ -1,16 +1,25 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Security_Ssh_Key_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Security_Ssh_Key_Service
+
 	CreateObject(template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetObject(sshkeyId int) (datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetObjectWithContext(ctx context.Context, sshkeyId int) (datatypes.SoftLayer_Security_Ssh_Key, error)
 	EditObject(sshkeyId int, template datatypes.SoftLayer_Security_Ssh_Key) (bool, error)
+	EditObjectWithContext(ctx context.Context, sshkeyId int, template datatypes.SoftLayer_Security_Ssh_Key) (bool, error)
 	DeleteObject(sshKeyId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, sshKeyId int) (bool, error)
 
 	GetSoftwarePasswords(sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error)
+	GetSoftwarePasswordsWithContext(ctx context.Context, sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error)
 }
@@ -1,11 +1,16 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Virtual_Disk_Image_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Virtual_Disk_Image_Service
+
 	GetObject(id int) (datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Disk_Image, error)
 }
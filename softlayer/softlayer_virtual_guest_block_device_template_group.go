@@ -1,38 +1,60 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+
 	AddLocations(id int, locations []datatypes.SoftLayer_Location) (bool, error)
+	AddLocationsWithContext(ctx context.Context, id int, locations []datatypes.SoftLayer_Location) (bool, error)
 
 	CreateFromExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	CreateFromExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	CreatePublicArchiveTransaction(id int, groupName string, summary string, note string, locations []datatypes.SoftLayer_Location) (int, error)
+	CreatePublicArchiveTransactionWithContext(ctx context.Context, id int, groupName string, summary string, note string, locations []datatypes.SoftLayer_Location) (int, error)
 	CopyToExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error)
+	CopyToExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error)
 
 	DeleteObject(id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	DeleteObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	DenySharingAccess(id int, accountId int) (bool, error)
+	DenySharingAccessWithContext(ctx context.Context, id int, accountId int) (bool, error)
 
 	GetObject(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	GetDatacenters(id int) ([]datatypes.SoftLayer_Location, error)
+	GetDatacentersWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error)
 	GetSshKeys(id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetStatus(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error)
+	GetStatusWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error)
 
 	GetStorageLocations(id int) ([]datatypes.SoftLayer_Location, error)
+	GetStorageLocationsWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error)
 
 	GetImageType(id int) (datatypes.SoftLayer_Image_Type, error)
+	GetImageTypeWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Image_Type, error)
 	GetImageTypeKeyName(id int) (string, error)
+	GetImageTypeKeyNameWithContext(ctx context.Context, id int) (string, error)
 
 	GetTransaction(id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetTransactionWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 
 	GetGlobalIdentifier(id int) (string, error)
+	GetGlobalIdentifierWithContext(ctx context.Context, id int) (string, error)
 
 	PermitSharingAccess(id int, accountId int) (bool, error)
+	PermitSharingAccessWithContext(ctx context.Context, id int, accountId int) (bool, error)
 
 	RemoveLocations(id int, locations []datatypes.SoftLayer_Location) (bool, error)
+	RemoveLocationsWithContext(ctx context.Context, id int, locations []datatypes.SoftLayer_Location) (bool, error)
 
 	SetAvailableLocations(id int, locations []datatypes.SoftLayer_Location) (bool, error)
+	SetAvailableLocationsWithContext(ctx context.Context, id int, locations []datatypes.SoftLayer_Location) (bool, error)
 }
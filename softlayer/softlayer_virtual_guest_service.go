@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -13,59 +15,109 @@ type UpgradeOptions struct {
 type SoftLayer_Virtual_Guest_Service interface {
 	Service
 
+	WithMask(mask ...string) SoftLayer_Virtual_Guest_Service
+
 	ActivatePrivatePort(instanceId int) (bool, error)
+	ActivatePrivatePortWithContext(ctx context.Context, instanceId int) (bool, error)
 	ActivatePublicPort(instanceId int) (bool, error)
+	ActivatePublicPortWithContext(ctx context.Context, instanceId int) (bool, error)
 	AttachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	AttachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	AttachEphemeralDisk(instanceId int, diskSize int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	AttachEphemeralDiskWithContext(ctx context.Context, instanceId int, diskSize int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 
 	CaptureImage(instanceId int) (datatypes.SoftLayer_Container_Disk_Image_Capture_Template, error)
+	CaptureImageWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Container_Disk_Image_Capture_Template, error)
 	CheckHostDiskAvailability(instanceId int, diskCapacity int) (bool, error)
+	CheckHostDiskAvailabilityWithContext(ctx context.Context, instanceId int, diskCapacity int) (bool, error)
 	ConfigureMetadataDisk(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	ConfigureMetadataDiskWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	CreateArchiveTransaction(instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	CreateArchiveTransactionWithContext(ctx context.Context, instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error)
 
 	DeleteObject(instanceId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, instanceId int) (bool, error)
 	DetachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	DetachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 
 	EditObject(instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error)
+	EditObjectWithContext(ctx context.Context, instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error)
 
 	IsPingable(instanceId int) (bool, error)
+	IsPingableWithContext(ctx context.Context, instanceId int) (bool, error)
 	IsBackendPingable(instanceId int) (bool, error)
+	IsBackendPingableWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	GetActiveTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetActiveTransactionWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetLastTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetLastTransactionWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetActiveTransactionsWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetAllowedHost(instanceId int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error)
+	GetAllowedHostWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error)
 	GetNetworkComponents(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
+	GetNetworkComponentsWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
 	GetNetworkVlans(instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error)
+	GetNetworkVlansWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error)
 	GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetObjectWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest, error)
 	GetObjectByPrimaryIpAddress(ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetObjectByPrimaryIpAddressWithContext(ctx context.Context, ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error)
 	GetObjectByPrimaryBackendIpAddress(ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetObjectByPrimaryBackendIpAddressWithContext(ctx context.Context, ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error)
 	GetPrimaryIpAddress(instanceId int) (string, error)
+	GetPrimaryIpAddressWithContext(ctx context.Context, instanceId int) (string, error)
 	GetPrimaryBackendIpAddress(instanceId int) (string, error)
+	GetPrimaryBackendIpAddressWithContext(ctx context.Context, instanceId int) (string, error)
 	GetPrimaryBackendNetworkComponent(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
+	GetPrimaryBackendNetworkComponentWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
 	GetPrimaryNetworkComponent(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
+	GetPrimaryNetworkComponentWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
 	GetPowerState(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error)
+	GetPowerStateWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error)
 	GetSshKeys(instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error)
+	GetTagReferencesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error)
 	GetUpgradeItemPrices(instanceId int) ([]datatypes.SoftLayer_Product_Item_Price, error)
+	GetUpgradeItemPricesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Product_Item_Price, error)
 	GetUserData(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error)
+	GetUserDataWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error)
 	GetAvailableUpgradeItemPrices(upgradeOptions *UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error)
+	GetAvailableUpgradeItemPricesWithContext(ctx context.Context, upgradeOptions *UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error)
 
 	PowerCycle(instanceId int) (bool, error)
+	PowerCycleWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOff(instanceId int) (bool, error)
+	PowerOffWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOffSoft(instanceId int) (bool, error)
+	PowerOffSoftWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOn(instanceId int) (bool, error)
+	PowerOnWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	RebootDefault(instanceId int) (bool, error)
+	RebootDefaultWithContext(ctx context.Context, instanceId int) (bool, error)
 	RebootSoft(instanceId int) (bool, error)
+	RebootSoftWithContext(ctx context.Context, instanceId int) (bool, error)
 	RebootHard(instanceId int) (bool, error)
+	RebootHardWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	SetMetadata(instanceId int, metadata string) (bool, error)
+	SetMetadataWithContext(ctx context.Context, instanceId int, metadata string) (bool, error)
 	SetTags(instanceId int, tags []string) (bool, error)
+	SetTagsWithContext(ctx context.Context, instanceId int, tags []string) (bool, error)
 	ShutdownPrivatePort(instanceId int) (bool, error)
+	ShutdownPrivatePortWithContext(ctx context.Context, instanceId int) (bool, error)
 	ShutdownPublicPort(instanceId int) (bool, error)
+	ShutdownPublicPortWithContext(ctx context.Context, instanceId int) (bool, error)
 	ReloadOperatingSystem(instanceId int, template datatypes.Image_Template_Config) error
+	ReloadOperatingSystemWithContext(ctx context.Context, instanceId int, template datatypes.Image_Template_Config) error
 
 	UpgradeObject(instanceId int, upgradeOptions *UpgradeOptions) (bool, error)
+	UpgradeObjectWithContext(ctx context.Context, instanceId int, upgradeOptions *UpgradeOptions) (bool, error)
+	PlaceUpgradeOrder(instanceId int, upgradeOptions *UpgradeOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceUpgradeOrderWithContext(ctx context.Context, instanceId int, upgradeOptions *UpgradeOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 }
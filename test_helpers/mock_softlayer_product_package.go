@@ -1,9 +1,11 @@
 package test_helpers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
 type MockProductPackageService struct{}
@@ -12,6 +14,10 @@ func (mock *MockProductPackageService) GetName() string {
 	return "Mock_Product_Package_Service"
 }
 
+func (mock *MockProductPackageService) WithMask(mask ...string) softlayer.SoftLayer_Product_Package_Service {
+	return mock
+}
+
 func (mock *MockProductPackageService) GetItemsByType(packageType string) ([]datatypes.SoftLayer_Product_Item, error) {
 	response, _ := ReadJsonTestFixtures("services", "SoftLayer_Product_Package_getItemsByType_virtual_server.json")
 
@@ -40,3 +46,27 @@ func (mock *MockProductPackageService) GetPackagesByType(packageType string) ([]
 func (mock *MockProductPackageService) GetOnePackageByType(packageType string) (datatypes.Softlayer_Product_Package, error) {
 	return datatypes.Softlayer_Product_Package{}, errors.New("Not supported")
 }
+
+func (mock *MockProductPackageService) GetItemsByTypeWithContext(ctx context.Context, packageType string) ([]datatypes.SoftLayer_Product_Item, error) {
+	return mock.GetItemsByType(packageType)
+}
+
+func (mock *MockProductPackageService) GetItemPricesWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	return mock.GetItemPrices(packageId)
+}
+
+func (mock *MockProductPackageService) GetItemPricesBySizeWithContext(ctx context.Context, packageId int, size int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	return mock.GetItemPricesBySize(packageId, size)
+}
+
+func (mock *MockProductPackageService) GetItemsWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Product_Item, error) {
+	return mock.GetItems(packageId)
+}
+
+func (mock *MockProductPackageService) GetPackagesByTypeWithContext(ctx context.Context, packageType string) ([]datatypes.Softlayer_Product_Package, error) {
+	return mock.GetPackagesByType(packageType)
+}
+
+func (mock *MockProductPackageService) GetOnePackageByTypeWithContext(ctx context.Context, packageType string) (datatypes.Softlayer_Product_Package, error) {
+	return mock.GetOnePackageByType(packageType)
+}
@@ -1,6 +1,7 @@
 package test_helpers
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -12,20 +13,27 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/config"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gexec"
 
 	slclient "github.com/maximilien/softlayer-go/client"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
 var (
 	TIMEOUT          time.Duration
 	POLLING_INTERVAL time.Duration
+
+	cassette     *slclient.Cassette
+	cassetteErr  error
+	cassetteOnce sync.Once
 )
 
 const (
@@ -39,6 +47,14 @@ const (
 
 	MAX_WAIT_RETRIES = 10
 	WAIT_TIME        = 5
+
+	//record or replay, the suites run against SoftLayer when not set
+	SL_CASSETTE_MODE_ENV = "SL_CASSETTE_MODE"
+	//Defaults to the cassettes directory of the suite
+	SL_CASSETTE_DIR_ENV = "SL_CASSETTE_DIR"
+
+	//Sent by CreateTestSshKey with cassettes instead of a generated key, so that replays get back the recorded key
+	CASSETTE_SSH_PUBLIC_KEY = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDUZITihKHoLooOh/XDwohg2uAYq7wwzwfBisSnamNvtmLg6aZf0aqFZziLSuIpf8tI7037ckk0Gtw5SrqnfzfN50fF1XeZKWFGjGY5kN7pp13jpBu4KgCBplFyExcMzBn4fXKM+aFSpC8VbpEqfcwa+fjlIMBMBS5BzKa94wZjeyDtqrPeKp/nTjLOa0FAnFhmWDIWR2SRrJ8B0i+UhyYafOpCPGg95/z81VsVzEZGyzrAzqci36Fzwou01fl9+/AVuxaZyro7yj+Pi9ZFmDultGXq6s5a7tNk4ehHNhAB50PnCvP534z86zAXup4M3Rruy26pvNw/AamLKJidu6y7 softlayer-go@cassettes"
 )
 
 func ReadJsonTestFixtures(packageName, fileName string) ([]byte, error) {
@@ -144,6 +160,36 @@ func GetUsernameAndApiKey() (string, string, error) {
 	return username, apiKey, nil
 }
 
+// CreateSoftLayerClient creates a client for SL_USERNAME and SL_API_KEY, recording its calls to or replaying them
+// from the cassette of the suite when SL_CASSETTE_MODE is set. No credentials are needed to replay.
+func CreateSoftLayerClient() (*slclient.SoftLayerClient, error) {
+	mode := os.Getenv(SL_CASSETTE_MODE_ENV)
+	if mode == slclient.CASSETTE_MODE_REPLAY {
+		cassette, err := suiteCassette(mode)
+		if err != nil {
+			return nil, err
+		}
+
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithCassetteReplay(cassette)), nil
+	}
+
+	username, apiKey, err := GetUsernameAndApiKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == slclient.CASSETTE_MODE_RECORD {
+		cassette, err := suiteCassette(mode)
+		if err != nil {
+			return nil, err
+		}
+
+		return slclient.NewSoftLayerClient(username, apiKey, slclient.WithCassetteRecording(cassette)), nil
+	}
+
+	return slclient.NewSoftLayerClient(username, apiKey), nil
+}
+
 func GetDatacenter() string {
 	datacenter := os.Getenv("SL_DATACENTER")
 	if datacenter == "" {
@@ -153,12 +199,11 @@ func GetDatacenter() string {
 }
 
 func CreateAccountService() (softlayer.SoftLayer_Account_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	accountService, err := client.GetSoftLayer_Account_Service()
 	if err != nil {
 		return nil, err
@@ -168,12 +213,11 @@ func CreateAccountService() (softlayer.SoftLayer_Account_Service, error) {
 }
 
 func CreateVirtualGuestService() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
 	if err != nil {
 		return nil, err
@@ -183,12 +227,11 @@ func CreateVirtualGuestService() (softlayer.SoftLayer_Virtual_Guest_Service, err
 }
 
 func CreateVirtualGuestBlockDeviceTemplateGroupService() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	vgbdtgService, err := client.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service()
 	if err != nil {
 		return nil, err
@@ -198,12 +241,11 @@ func CreateVirtualGuestBlockDeviceTemplateGroupService() (softlayer.SoftLayer_Vi
 }
 
 func CreateSecuritySshKeyService() (softlayer.SoftLayer_Security_Ssh_Key_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	sshKeyService, err := client.GetSoftLayer_Security_Ssh_Key_Service()
 	if err != nil {
 		return nil, err
@@ -213,12 +255,11 @@ func CreateSecuritySshKeyService() (softlayer.SoftLayer_Security_Ssh_Key_Service
 }
 
 func CreateProductPackageService() (softlayer.SoftLayer_Product_Package_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	productPackageService, err := client.GetSoftLayer_Product_Package_Service()
 	if err != nil {
 		return nil, err
@@ -228,12 +269,11 @@ func CreateProductPackageService() (softlayer.SoftLayer_Product_Package_Service,
 }
 
 func CreateNetworkStorageService() (softlayer.SoftLayer_Network_Storage_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	networkStorageService, err := client.GetSoftLayer_Network_Storage_Service()
 	if err != nil {
 		return nil, err
@@ -329,8 +369,12 @@ func GenerateSshKey() (string, string, error) {
 }
 
 func CreateTestSshKey() (datatypes.SoftLayer_Security_Ssh_Key, string) {
-	_, testSshKeyValue, err := GenerateSshKey()
-	Expect(err).ToNot(HaveOccurred())
+	testSshKeyValue := CASSETTE_SSH_PUBLIC_KEY
+	if os.Getenv(SL_CASSETTE_MODE_ENV) == "" {
+		var err error
+		_, testSshKeyValue, err = GenerateSshKey()
+		Expect(err).ToNot(HaveOccurred())
+	}
 
 	sshKey := datatypes.SoftLayer_Security_Ssh_Key{
 		Key:   strings.Trim(string(testSshKeyValue), "\n"),
@@ -454,12 +498,14 @@ func WaitForVirtualGuest(virtualGuestId int, targetState string, timeout time.Du
 	Expect(err).ToNot(HaveOccurred())
 
 	fmt.Printf("----> waiting for virtual guest: %d, until %s\n", virtualGuestId, targetState)
-	Eventually(func() string {
-		vgPowerState, err := virtualGuestService.GetPowerState(virtualGuestId)
-		Expect(err).ToNot(HaveOccurred())
-		fmt.Printf("----> virtual guest: %d, has power state: %s\n", virtualGuestId, vgPowerState.KeyName)
-		return vgPowerState.KeyName
-	}, timeout, POLLING_INTERVAL).Should(Equal(targetState), fmt.Sprintf("failed waiting for virtual guest to be %s", targetState))
+	err = services.NewVirtualGuestWaiter(virtualGuestService).WaitForPowerState(context.Background(), virtualGuestId, targetState, services.WaitOptions{
+		PollingInterval: POLLING_INTERVAL,
+		Timeout:         timeout,
+		OnProgress: func(progress services.WaitProgress) {
+			fmt.Printf("----> virtual guest: %d, has power state: %s\n", virtualGuestId, progress.PowerState)
+		},
+	})
+	Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("failed waiting for virtual guest to be %s", targetState))
 }
 
 func WaitForVirtualGuestToBeRunning(virtualGuestId int) {
@@ -489,12 +535,14 @@ func WaitForVirtualGuestToHaveNoActiveTransactions(virtualGuestId int) {
 	Expect(err).ToNot(HaveOccurred())
 
 	fmt.Printf("----> waiting for virtual guest to have no active transactions pending\n")
-	Eventually(func() int {
-		activeTransactions, err := virtualGuestService.GetActiveTransactions(virtualGuestId)
-		Expect(err).ToNot(HaveOccurred())
-		fmt.Printf("----> virtual guest: %d, has %d active transactions\n", virtualGuestId, len(activeTransactions))
-		return len(activeTransactions)
-	}, TIMEOUT, POLLING_INTERVAL).Should(Equal(0), "failed waiting for virtual guest to have no active transactions")
+	err = services.NewVirtualGuestWaiter(virtualGuestService).WaitForTransactions(context.Background(), virtualGuestId, services.WaitOptions{
+		PollingInterval: POLLING_INTERVAL,
+		Timeout:         TIMEOUT,
+		OnProgress: func(progress services.WaitProgress) {
+			fmt.Printf("----> virtual guest: %d, has %d active transactions\n", virtualGuestId, len(progress.Transactions))
+		},
+	})
+	Expect(err).ToNot(HaveOccurred(), "failed waiting for virtual guest to have no active transactions")
 }
 
 func WaitForVirtualGuestToHaveNoActiveTransactionsOrToErr(virtualGuestId int) {
@@ -590,7 +638,7 @@ func SetUserDataToVirtualGuest(virtualGuestId int, metadata string) {
 	success, err := virtualGuestService.SetMetadata(virtualGuestId, metadata)
 	Expect(err).ToNot(HaveOccurred())
 	Expect(success).To(BeTrue())
-	fmt.Printf(fmt.Sprintf("----> successfully set metadata: `%s` to virtual guest instance: %d\n", metadata, virtualGuestId))
+	fmt.Printf("----> successfully set metadata: `%s` to virtual guest instance: %d\n", metadata, virtualGuestId)
 }
 
 func ConfigureMetadataDiskOnVirtualGuest(virtualGuestId int) datatypes.SoftLayer_Provisioning_Version1_Transaction {
@@ -599,7 +647,7 @@ func ConfigureMetadataDiskOnVirtualGuest(virtualGuestId int) datatypes.SoftLayer
 
 	transaction, err := virtualGuestService.ConfigureMetadataDisk(virtualGuestId)
 	Expect(err).ToNot(HaveOccurred())
-	fmt.Printf(fmt.Sprintf("----> successfully configured metadata disk for virtual guest instance: %d\n", virtualGuestId))
+	fmt.Printf("----> successfully configured metadata disk for virtual guest instance: %d\n", virtualGuestId)
 
 	return transaction
 }
@@ -673,12 +721,11 @@ func GetVirtualGuestPrimaryIpAddress(virtualGuestId int) string {
 }
 
 func CreateDnsDomainService() (softlayer.SoftLayer_Dns_Domain_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	dnsDomainService, err := client.GetSoftLayer_Dns_Domain_Service()
 	if err != nil {
 		return nil, err
@@ -688,12 +735,11 @@ func CreateDnsDomainService() (softlayer.SoftLayer_Dns_Domain_Service, error) {
 }
 
 func CreateDnsDomainResourceRecordService() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateSoftLayerClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	dnsDomainResourceRecordService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_Service()
 	if err != nil {
 		return nil, err
@@ -884,3 +930,39 @@ func generateSshKeyUsingSshKeyGen() (string, string, error) {
 
 	return string(privateKey), string(publicKey), nil
 }
+
+// suiteCassette is shared by all the clients of the suite, so that the interactions are recorded and replayed in order.
+// Parallel ginkgo nodes would split the specs, and the cassette file, between them: the suite must run serially.
+func suiteCassette(mode string) (*slclient.Cassette, error) {
+	cassetteOnce.Do(func() {
+		if config.GinkgoConfig.ParallelTotal > 1 {
+			cassetteErr = errors.New(fmt.Sprintf("softlayer-go: cannot %s cassettes with %d parallel ginkgo nodes, run the suite without -p", mode, config.GinkgoConfig.ParallelTotal))
+			return
+		}
+
+		wd, err := os.Getwd()
+		if err != nil {
+			cassetteErr = err
+			return
+		}
+
+		dir := os.Getenv(SL_CASSETTE_DIR_ENV)
+		if dir == "" {
+			dir = filepath.Join(wd, "cassettes")
+		}
+
+		path := filepath.Join(dir, filepath.Base(wd)+".json")
+		if mode == slclient.CASSETTE_MODE_RECORD {
+			cassette = slclient.NewCassette(path)
+			return
+		}
+
+		cassette, cassetteErr = slclient.LoadCassette(path)
+		if cassetteErr == nil {
+			//Bodies hold generated SSH keys, hostnames and dates
+			cassette.Matcher = slclient.MatchMethodAndPath
+		}
+	})
+
+	return cassette, cassetteErr
+}